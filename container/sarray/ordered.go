@@ -0,0 +1,58 @@
+package sarray
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SortOrdered sorts the array of ordered type in ascending order, in-place.
+//
+//	@param a *Array[V]
+//	@return *Array[V]
+//	@player
+func SortOrdered[V cmp.Ordered](a *Array[V]) *Array[V] {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slices.Sort(a.data)
+	return a
+}
+
+// SortOrderedDesc sorts the array of ordered type in descending order, in-place.
+//
+//	@param a *Array[V]
+//	@return *Array[V]
+//	@player
+func SortOrderedDesc[V cmp.Ordered](a *Array[V]) *Array[V] {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	slices.SortFunc(a.data, func(v1, v2 V) int {
+		return cmp.Compare(v2, v1)
+	})
+	return a
+}
+
+// MinMax returns the minimum and maximum elements of the array of ordered type in a single pass,
+// ok is false if the array is empty.
+//
+//	@param a *Array[V]
+//	@return min V
+//	@return max V
+//	@return ok bool
+//	@player
+func MinMax[V cmp.Ordered](a *Array[V]) (min, max V, ok bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if len(a.data) == 0 {
+		return
+	}
+	min, max = a.data[0], a.data[0]
+	for _, v := range a.data[1:] {
+		if cmp.Less(v, min) {
+			min = v
+		}
+		if cmp.Less(max, v) {
+			max = v
+		}
+	}
+	return min, max, true
+}
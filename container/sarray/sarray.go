@@ -25,15 +25,22 @@ package sarray
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
+	"runtime"
 	"sort"
+	"sync"
 
 	"github.com/go-fox/sugar/internal/json"
 	"github.com/go-fox/sugar/internal/rwmutex"
 	"github.com/go-fox/sugar/util/sconv"
+	"github.com/go-fox/sugar/util/sslice"
 )
 
+// chanBufferSize is the buffer size of the channel returned by Array.Chan.
+const chanBufferSize = 64
+
 // New new and returns an empty array.
 //
 //	@param safe ...bool
@@ -46,6 +53,19 @@ func New[V any](safe ...bool) *Array[V] {
 	}
 }
 
+// NewWithCapacity new and returns an empty array with pre-allocated capacity.
+//
+//	@param capacity int
+//	@param safe ...bool
+//	@return *Array[V]
+//	@player
+func NewWithCapacity[V any](capacity int, safe ...bool) *Array[V] {
+	return &Array[V]{
+		mu:   rwmutex.New(safe...),
+		data: make([]V, 0, capacity),
+	}
+}
+
 // NewFromSlice returns an array of specified slices
 //
 //	@param data []T
@@ -59,6 +79,26 @@ func NewFromSlice[T any](data []T, safe ...bool) *Array[T] {
 	}
 }
 
+// Merge returns a new safe array containing all elements of the arrays in order.
+// The read lock of each array is acquired one after another, never all at once.
+//
+//	@param arrays ...*Array[V]
+//	@return *Array[V]
+//	@player
+func Merge[V any](arrays ...*Array[V]) *Array[V] {
+	size := 0
+	for _, a := range arrays {
+		size += a.Size()
+	}
+	data := make([]V, 0, size)
+	for _, a := range arrays {
+		a.mu.RLock()
+		data = append(data, a.data...)
+		a.mu.RUnlock()
+	}
+	return NewFromSlice(data, true)
+}
+
 // Array wraps map type `[]any` and provides more map features.
 type Array[V any] struct {
 	mu   *rwmutex.RWMutex
@@ -104,6 +144,67 @@ func (s *Array[V]) IteratorDesc(f func(key int, v V) bool) {
 	}
 }
 
+// Chan returns a channel that receives the elements in ascending order, so that the array can be
+// iterated with `for v := range s.Chan(ctx)`. The channel is closed when all elements are sent
+// or the context is done. The read lock is held until all elements are sent, so the channel must be drained
+// or the context cancelled.
+//
+//	@receiver s
+//	@param ctx context.Context
+//	@return <-chan V
+//	@player
+func (s *Array[V]) Chan(ctx context.Context) <-chan V {
+	ch := make(chan V, chanBufferSize)
+	go func() {
+		defer close(ch)
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+		for _, datum := range s.data {
+			select {
+			case ch <- datum:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// ForEachParallel calls `f` for every element concurrently, the array is divided among `workers` goroutines.
+// The elements are snapshotted under the read lock, then `f` is called without holding the lock.
+// runtime.NumCPU() workers are used if workers <= 0.
+//
+//	@receiver s
+//	@param f func(index int, v V)
+//	@param workers int
+//	@player
+func (s *Array[V]) ForEachParallel(f func(index int, v V), workers int) {
+	s.mu.RLock()
+	data := make([]V, len(s.data))
+	copy(data, s.data)
+	s.mu.RUnlock()
+	if len(data) == 0 {
+		return
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	workers = min(workers, len(data))
+	size := (len(data) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < len(data); start += size {
+		end := min(start+size, len(data))
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for i := start; i < end; i++ {
+				f(i, data[i])
+			}
+		}(start, end)
+	}
+	wg.Wait()
+}
+
 // Push append elements at the tail
 //
 //	@receiver s
@@ -141,6 +242,32 @@ func (s *Array[V]) RemoveValue(value V) (int, bool) {
 	return index, ok
 }
 
+// RemoveAll Removes all elements that satisfy the given callback function `f`
+//
+//	@receiver s
+//	@param f func(v V) bool returns true, the value remove; or false keep this value
+//	@return int the number of elements removed
+//	@player
+func (s *Array[V]) RemoveAll(f func(v V) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, datum := range s.data {
+		if !f(datum) {
+			s.data[n] = datum
+			n++
+		}
+	}
+	removed := len(s.data) - n
+	// Clear the tail so that removed elements can be garbage collected.
+	var zero V
+	for i := n; i < len(s.data); i++ {
+		s.data[i] = zero
+	}
+	s.data = s.data[:n]
+	return removed
+}
+
 // Search finds the specified value and return the position of the value in the array
 //
 //	@receiver s
@@ -181,6 +308,31 @@ func (s *Array[V]) Set(index int, element V) {
 	s.data[index] = element
 }
 
+// SetAll Replaces the elements at the specified positions in one critical section.
+// Out of range indexes are reported by the returned error, all valid ones are still applied.
+//
+//	@receiver s
+//	@param entries map[int]V index of the element to replace and the element to be stored
+//	@return error
+//	@player
+func (s *Array[V]) SetAll(entries map[int]V) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var invalid []int
+	for index, element := range entries {
+		if index < 0 || index >= len(s.data) {
+			invalid = append(invalid, index)
+			continue
+		}
+		s.data[index] = element
+	}
+	if len(invalid) > 0 {
+		sort.Ints(invalid)
+		return fmt.Errorf("sarray.SetAll: index out of bounds: %v", invalid)
+	}
+	return nil
+}
+
 // Insert inserts a value at the specified position, move the current value back
 //
 //	@receiver s
@@ -291,7 +443,7 @@ func (s *Array[V]) Unique() *Array[V] {
 			continue
 		}
 		uniqueSet[temp] = struct{}{}
-		uniqueArray = append(uniqueArray, temp)
+		uniqueArray = append(uniqueArray, s.data[i])
 	}
 	s.data = uniqueArray
 	return s
@@ -386,6 +538,109 @@ func (s *Array[V]) Filter(f func(index int, v V) bool) []V {
 	return result
 }
 
+// TakeWhile returns the elements from the start of the array while `f` returns true,
+// stopping at the first false.
+//
+//	@receiver s
+//	@param f func(v V) bool
+//	@return []V
+//	@player
+func (s *Array[V]) TakeWhile(f func(v V) bool) []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i := 0
+	for i < len(s.data) && f(s.data[i]) {
+		i++
+	}
+	result := make([]V, i)
+	copy(result, s.data[:i])
+	return result
+}
+
+// DropWhile skips the elements from the start of the array while `f` returns true,
+// and returns the rest.
+//
+//	@receiver s
+//	@param f func(v V) bool
+//	@return []V
+//	@player
+func (s *Array[V]) DropWhile(f func(v V) bool) []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	i := 0
+	for i < len(s.data) && f(s.data[i]) {
+		i++
+	}
+	result := make([]V, len(s.data)-i)
+	copy(result, s.data[i:])
+	return result
+}
+
+// Diff compares the array with `other` using reflect.DeepEqual, `added` contains the elements of other
+// that are not in this array, `removed` contains the elements of this array that are not in other.
+//
+//	@receiver s
+//	@param other *Array[V]
+//	@return added []V
+//	@return removed []V
+//	@player
+func (s *Array[V]) Diff(other *Array[V]) (added []V, removed []V) {
+	// copy other first, so that the read locks of both arrays are never held at once
+	otherData := other.snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	added, removed = make([]V, 0), make([]V, 0)
+	for _, datum := range otherData {
+		if indexOf(s.data, datum) == -1 {
+			added = append(added, datum)
+		}
+	}
+	for _, datum := range s.data {
+		if indexOf(otherData, datum) == -1 {
+			removed = append(removed, datum)
+		}
+	}
+	return added, removed
+}
+
+// Equal reports whether the array and `other` have the same length and all corresponding elements
+// are reflect.DeepEqual.
+//
+//	@receiver s
+//	@param other *Array[V]
+//	@return bool
+//	@player
+func (s *Array[V]) Equal(other *Array[V]) bool {
+	if other == s {
+		return true
+	}
+	// copy other first, so that the read locks of both arrays are never held at once
+	otherData := other.snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.data) != len(otherData) {
+		return false
+	}
+	for i := range s.data {
+		if !reflect.DeepEqual(s.data[i], otherData[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Chunk splits the elements of the array into groups the length of size, see sslice.Chunk.
+//
+//	@receiver s
+//	@param size int
+//	@return [][]V
+//	@player
+func (s *Array[V]) Chunk(size int) [][]V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sslice.Chunk(s.data, size)
+}
+
 // Size returns the number of elements in this array.
 //
 //	@receiver s
@@ -397,9 +652,22 @@ func (s *Array[V]) Size() int {
 	return len(s.data)
 }
 
+// snapshot returns a copy of the data under the read lock.
+func (s *Array[V]) snapshot() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := make([]V, len(s.data))
+	copy(data, s.data)
+	return data
+}
+
 func (s *Array[V]) search(value V) int {
+	return indexOf(s.data, value)
+}
+
+func indexOf[V any](data []V, value V) int {
 	result := -1
-	for index, datum := range s.data {
+	for index, datum := range data {
 		if reflect.DeepEqual(datum, value) {
 			result = index
 			break
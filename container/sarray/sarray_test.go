@@ -0,0 +1,61 @@
+package sarray
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestForEachParallel(t *testing.T) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+	arr := NewFromSlice(data, true)
+	for _, workers := range []int{0, 1, 3, 7, 2000} {
+		var count, sum atomic.Int64
+		arr.ForEachParallel(func(index int, v int) {
+			count.Add(1)
+			sum.Add(int64(v))
+		}, workers)
+		if count.Load() != int64(arr.Size()) {
+			t.Fatalf("workers %d: f called %d times, expected %d", workers, count.Load(), arr.Size())
+		}
+		if sum.Load() != 999*1000/2 {
+			t.Fatalf("workers %d: sum %d, expected %d", workers, sum.Load(), 999*1000/2)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	type point struct {
+		X, Y int
+		Tags []string
+	}
+	structs := NewFromSlice([]point{{1, 2, []string{"a"}}, {3, 4, nil}}, true)
+	if !structs.Equal(NewFromSlice([]point{{1, 2, []string{"a"}}, {3, 4, nil}}, true)) {
+		t.Fatal("equal arrays of structs are not equal")
+	}
+	if structs.Equal(NewFromSlice([]point{{1, 2, []string{"b"}}, {3, 4, nil}}, true)) {
+		t.Fatal("different arrays of structs are equal")
+	}
+	if !structs.Equal(structs) {
+		t.Fatal("array is not equal to itself")
+	}
+
+	a, b := 1, 1
+	pointers := NewFromSlice([]*int{&a, nil}, true)
+	if !pointers.Equal(NewFromSlice([]*int{&b, nil}, true)) {
+		t.Fatal("arrays of pointers to equal values are not equal")
+	}
+	if pointers.Equal(NewFromSlice([]*int{&a}, true)) {
+		t.Fatal("arrays of different lengths are equal")
+	}
+
+	interfaces := NewFromSlice([]interface{}{1, "a", []int{1}, nil}, true)
+	if !interfaces.Equal(NewFromSlice([]interface{}{1, "a", []int{1}, nil}, true)) {
+		t.Fatal("equal arrays of interfaces are not equal")
+	}
+	if interfaces.Equal(NewFromSlice([]interface{}{int64(1), "a", []int{1}, nil}, true)) {
+		t.Fatal("arrays of interfaces with different dynamic types are equal")
+	}
+}
@@ -0,0 +1,116 @@
+package satomic
+
+import "sync"
+
+// Map warp sync.Map with typed keys and values
+type Map[K comparable, V any] struct {
+	m sync.Map
+}
+
+// NewMap new a typed sync.Map
+//
+//	@return *Map[K, V]
+//	@player
+func NewMap[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{}
+}
+
+// Load implements the interface Load for sync.Map.
+//
+//	@receiver m
+//	@param key K
+//	@return value V
+//	@return ok bool
+//	@player
+func (m *Map[K, V]) Load(key K) (value V, ok bool) {
+	v, ok := m.m.Load(key)
+	return cast[V](v), ok
+}
+
+// Store implements the interface Store for sync.Map.
+//
+//	@receiver m
+//	@param key K
+//	@param value V
+//	@player
+func (m *Map[K, V]) Store(key K, value V) {
+	m.m.Store(key, value)
+}
+
+// Delete implements the interface Delete for sync.Map.
+//
+//	@receiver m
+//	@param key K
+//	@player
+func (m *Map[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// LoadOrStore implements the interface LoadOrStore for sync.Map.
+//
+//	@receiver m
+//	@param key K
+//	@param value V
+//	@return actual V
+//	@return loaded bool
+//	@player
+func (m *Map[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	v, loaded := m.m.LoadOrStore(key, value)
+	return cast[V](v), loaded
+}
+
+// LoadAndDelete implements the interface LoadAndDelete for sync.Map.
+//
+//	@receiver m
+//	@param key K
+//	@return value V
+//	@return loaded bool
+//	@player
+func (m *Map[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	v, loaded := m.m.LoadAndDelete(key)
+	return cast[V](v), loaded
+}
+
+// Range implements the interface Range for sync.Map.
+//
+//	@receiver m
+//	@param f func(key K, value V) bool returns true, then it continues iterating; or false to stop.
+//	@player
+func (m *Map[K, V]) Range(f func(key K, value V) bool) {
+	m.m.Range(func(key, value any) bool {
+		return f(key.(K), cast[V](value))
+	})
+}
+
+// Swap implements the interface Swap for sync.Map.
+//
+//	@receiver m
+//	@param key K
+//	@param value V
+//	@return previous V
+//	@return loaded bool
+//	@player
+func (m *Map[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	v, loaded := m.m.Swap(key, value)
+	return cast[V](v), loaded
+}
+
+// CompareAndSwap implements the interface CompareAndSwap for sync.Map.
+// The dynamic type of the old value must be comparable, or it panics.
+//
+//	@receiver m
+//	@param key K
+//	@param old V
+//	@param new V
+//	@return swapped bool
+//	@player
+func (m *Map[K, V]) CompareAndSwap(key K, old, new V) (swapped bool) {
+	return m.m.CompareAndSwap(key, old, new)
+}
+
+// cast asserts a value of the underlying sync.Map to V, it returns the zero value of V for a nil value,
+// which happens when V is an interface type, such as Map[string, error] storing a nil error.
+func cast[V any](v any) V {
+	val, _ := v.(V)
+	return val
+}
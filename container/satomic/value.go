@@ -26,15 +26,19 @@ package satomic
 import (
 	"fmt"
 	"reflect"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/go-fox/sugar/internal/json"
 	"github.com/go-fox/sugar/util/sconv"
 )
 
 // Value warp atomic.Value
 type Value[T any] struct {
 	atomic.Value
+	watchMu  sync.Mutex
+	watchers []func(old, new T)
 }
 
 // New newa atomic value
@@ -56,13 +60,28 @@ func (v *Value[T]) Load() T {
 	return v.Value.Load().(T)
 }
 
+// LoadDefault returns the stored value, or `defaultVal` if the value has never been stored.
+//
+//	@receiver v
+//	@param defaultVal T
+//	@return T
+//	@player
+func (v *Value[T]) LoadDefault(defaultVal T) T {
+	val, ok := v.Value.Load().(T)
+	if !ok {
+		return defaultVal
+	}
+	return val
+}
+
 // Store implements the interface Store for atomic.Value.
 //
 //	@receiver v
 //	@param val T
 //	@player
 func (v *Value[T]) Store(val T) {
-	v.Value.Store(val)
+	old := v.Value.Swap(val)
+	v.notify(old, val)
 }
 
 // Swap implements the interface Swap for atomic.Value.
@@ -72,8 +91,9 @@ func (v *Value[T]) Store(val T) {
 //	@return old
 //	@player
 func (v *Value[T]) Swap(new T) (old T) {
-	old = v.Value.Load().(T)
-	v.Value.Store(new)
+	prev := v.Value.Swap(new)
+	v.notify(prev, new)
+	old, _ = prev.(T)
 	return old
 }
 
@@ -85,7 +105,61 @@ func (v *Value[T]) Swap(new T) (old T) {
 //	@return swapped
 //	@player
 func (v *Value[T]) CompareAndSwap(old, new T) (swapped bool) {
-	return v.Value.CompareAndSwap(old, new)
+	swapped = v.Value.CompareAndSwap(old, new)
+	if swapped {
+		v.notify(old, new)
+	}
+	return swapped
+}
+
+// Watch registers a callback function `f`, it is called synchronously after every Store,
+// Swap or CompareAndSwap that changes the stored value.
+//
+//	@receiver v
+//	@param f func(old, new T)
+//	@player
+func (v *Value[T]) Watch(f func(old, new T)) {
+	v.watchMu.Lock()
+	defer v.watchMu.Unlock()
+	v.watchers = append(v.watchers, f)
+}
+
+// StopWatch removes all callback functions registered by Watch.
+//
+//	@receiver v
+//	@player
+func (v *Value[T]) StopWatch() {
+	v.watchMu.Lock()
+	defer v.watchMu.Unlock()
+	v.watchers = nil
+}
+
+// MarshalJSON implements the interface MarshalJSON for json.Marshal, it marshals the stored value,
+// or the zero value of T if the value has never been stored.
+//
+//	@receiver v
+//	@return []byte
+//	@return error
+//	@player
+func (v *Value[T]) MarshalJSON() ([]byte, error) {
+	var zero T
+	return json.Marshal(v.LoadDefault(zero))
+}
+
+// UnmarshalJSON implements the interface UnmarshalJSON for json.Unmarshal, it unmarshals `data`
+// into a new T and stores it.
+//
+//	@receiver v
+//	@param data []byte
+//	@return error
+//	@player
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	v.Store(val)
+	return nil
 }
 
 // IsEmpty implements the interface IsZero for reflect.Value.
@@ -203,6 +277,23 @@ func (v *Value[T]) StringMap() (map[string]string, error) {
 	return ret, nil
 }
 
+func (v *Value[T]) notify(prev any, new T) {
+	v.watchMu.Lock()
+	watchers := v.watchers
+	v.watchMu.Unlock()
+	if len(watchers) == 0 {
+		return
+	}
+	old, _ := prev.(T)
+	if prev != nil && reflect.DeepEqual(old, new) {
+		return
+	}
+	// callbacks are called outside the lock, so they can call Watch or Store again.
+	for _, f := range watchers {
+		f(old, new)
+	}
+}
+
 func (v *Value[T]) typeAssertError() error {
 	return fmt.Errorf("type assert to %v failed", reflect.TypeOf(v.Load()))
 }
@@ -0,0 +1,87 @@
+package satomic
+
+import "sync/atomic"
+
+// Versioned stores a value together with a version, which is increased on every store.
+// It is used for lock-free optimistic updates.
+type Versioned[T any] struct {
+	p atomic.Pointer[versioned[T]]
+}
+
+type versioned[T any] struct {
+	Value   T
+	Version uint64
+}
+
+// NewVersioned new a versioned atomic value
+//
+//	@return *Versioned[T]
+//	@player
+func NewVersioned[T any]() *Versioned[T] {
+	return &Versioned[T]{}
+}
+
+// Load returns the current value and version, the version is 0 if the value has never been stored.
+//
+//	@receiver v
+//	@return T
+//	@return uint64
+//	@player
+func (v *Versioned[T]) Load() (T, uint64) {
+	cur := v.p.Load()
+	if cur == nil {
+		var zero T
+		return zero, 0
+	}
+	return cur.Value, cur.Version
+}
+
+// Store stores the value and returns the new version.
+//
+//	@receiver v
+//	@param val T
+//	@return uint64
+//	@player
+func (v *Versioned[T]) Store(val T) uint64 {
+	for {
+		cur := v.p.Load()
+		next := &versioned[T]{Value: val, Version: version(cur) + 1}
+		if v.p.CompareAndSwap(cur, next) {
+			return next.Version
+		}
+	}
+}
+
+// CompareAndSwapVersioned stores the new value only if the current version equals `expected`.
+// It returns the value and version stored after the operation, and whether the swap happened.
+//
+//	@receiver v
+//	@param expected uint64
+//	@param new T
+//	@return T
+//	@return uint64
+//	@return bool
+//	@player
+func (v *Versioned[T]) CompareAndSwapVersioned(expected uint64, new T) (T, uint64, bool) {
+	for {
+		cur := v.p.Load()
+		if version(cur) != expected {
+			if cur == nil {
+				var zero T
+				return zero, 0, false
+			}
+			return cur.Value, cur.Version, false
+		}
+		next := &versioned[T]{Value: new, Version: expected + 1}
+		if v.p.CompareAndSwap(cur, next) {
+			return next.Value, next.Version, true
+		}
+	}
+}
+
+func version[T any](v *versioned[T]) uint64 {
+	if v == nil {
+		return 0
+	}
+	return v.Version
+}
@@ -0,0 +1,231 @@
+// Package sbitset
+// MIT License
+//
+// # Copyright (c) 2024 sugar
+// Author https://github.com/go-fox/sugar
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package sbitset
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const wordSize = 64
+
+// BitSet is a dense bit set backed by a uint64 word array, it grows automatically.
+// It is not safe for concurrent use.
+type BitSet struct {
+	words []uint64
+}
+
+// New new a BitSet with room for `size` bits
+//
+//	@param size uint
+//	@return *BitSet
+//	@player
+func New(size uint) *BitSet {
+	return &BitSet{
+		words: make([]uint64, wordsNeeded(size)),
+	}
+}
+
+// FromBytes returns a BitSet from the bytes returned by Bytes
+//
+//	@param data []byte
+//	@return *BitSet
+//	@player
+func FromBytes(data []byte) *BitSet {
+	b := &BitSet{words: make([]uint64, (len(data)+7)/8)}
+	buf := make([]byte, len(b.words)*8)
+	copy(buf, data)
+	for i := range b.words {
+		b.words[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	return b
+}
+
+// Len returns the number of bits the BitSet has room for
+//
+//	@receiver b
+//	@return uint
+//	@player
+func (b *BitSet) Len() uint {
+	return uint(len(b.words)) * wordSize
+}
+
+// Set sets the bit n
+//
+//	@receiver b
+//	@param n uint
+//	@player
+func (b *BitSet) Set(n uint) {
+	b.grow(n)
+	b.words[n/wordSize] |= 1 << (n % wordSize)
+}
+
+// Clear clears the bit n
+//
+//	@receiver b
+//	@param n uint
+//	@player
+func (b *BitSet) Clear(n uint) {
+	if n >= b.Len() {
+		return
+	}
+	b.words[n/wordSize] &^= 1 << (n % wordSize)
+}
+
+// Test reports whether the bit n is set
+//
+//	@receiver b
+//	@param n uint
+//	@return bool
+//	@player
+func (b *BitSet) Test(n uint) bool {
+	if n >= b.Len() {
+		return false
+	}
+	return b.words[n/wordSize]&(1<<(n%wordSize)) != 0
+}
+
+// Toggle flips the bit n
+//
+//	@receiver b
+//	@param n uint
+//	@player
+func (b *BitSet) Toggle(n uint) {
+	b.grow(n)
+	b.words[n/wordSize] ^= 1 << (n % wordSize)
+}
+
+// And returns a new BitSet of the intersection of b and other
+//
+//	@receiver b
+//	@param other *BitSet
+//	@return *BitSet
+//	@player
+func (b *BitSet) And(other *BitSet) *BitSet {
+	result := &BitSet{words: make([]uint64, min(len(b.words), len(other.words)))}
+	for i := range result.words {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	return result
+}
+
+// Or returns a new BitSet of the union of b and other
+//
+//	@receiver b
+//	@param other *BitSet
+//	@return *BitSet
+//	@player
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new BitSet of the symmetric difference of b and other
+//
+//	@receiver b
+//	@param other *BitSet
+//	@return *BitSet
+//	@player
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// Count returns the number of set bits
+//
+//	@receiver b
+//	@return int
+//	@player
+func (b *BitSet) Count() int {
+	count := 0
+	for _, word := range b.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// NextSet returns the first set bit from `from` inclusive, ok is false if there is none
+//
+//	@receiver b
+//	@param from uint
+//	@return uint
+//	@return bool
+//	@player
+func (b *BitSet) NextSet(from uint) (uint, bool) {
+	i := from / wordSize
+	if i >= uint(len(b.words)) {
+		return 0, false
+	}
+	// mask the bits before `from` in the first word
+	word := b.words[i] >> (from % wordSize)
+	if word != 0 {
+		return from + uint(bits.TrailingZeros64(word)), true
+	}
+	for i++; i < uint(len(b.words)); i++ {
+		if b.words[i] != 0 {
+			return i*wordSize + uint(bits.TrailingZeros64(b.words[i])), true
+		}
+	}
+	return 0, false
+}
+
+// Bytes returns the bits as bytes, the words are encoded in little endian order
+//
+//	@receiver b
+//	@return []byte
+//	@player
+func (b *BitSet) Bytes() []byte {
+	data := make([]byte, len(b.words)*8)
+	for i, word := range b.words {
+		binary.LittleEndian.PutUint64(data[i*8:], word)
+	}
+	return data
+}
+
+func (b *BitSet) combine(other *BitSet, op func(x, y uint64) uint64) *BitSet {
+	result := &BitSet{words: make([]uint64, max(len(b.words), len(other.words)))}
+	for i := range result.words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		result.words[i] = op(x, y)
+	}
+	return result
+}
+
+func (b *BitSet) grow(n uint) {
+	if n < b.Len() {
+		return
+	}
+	// at least double the words to amortize the growth
+	words := make([]uint64, max(wordsNeeded(n+1), 2*len(b.words)))
+	copy(words, b.words)
+	b.words = words
+}
+
+func wordsNeeded(size uint) int {
+	return int((size + wordSize - 1) / wordSize)
+}
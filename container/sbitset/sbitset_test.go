@@ -0,0 +1,92 @@
+package sbitset
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNextSet(t *testing.T) {
+	b := New(256)
+	for _, n := range []uint{0, 63, 64, 130, 255} {
+		b.Set(n)
+	}
+	tests := []struct {
+		from uint
+		next uint
+		ok   bool
+	}{
+		{0, 0, true},
+		{1, 63, true},
+		{63, 63, true},
+		{64, 64, true},
+		{65, 130, true},
+		{131, 255, true},
+		{256, 0, false},
+		{1000, 0, false},
+	}
+	for _, tt := range tests {
+		next, ok := b.NextSet(tt.from)
+		if next != tt.next || ok != tt.ok {
+			t.Fatalf("NextSet(%d) = %d, %v, expected %d, %v", tt.from, next, ok, tt.next, tt.ok)
+		}
+	}
+	if _, ok := New(128).NextSet(0); ok {
+		t.Fatal("NextSet on an empty BitSet found a bit")
+	}
+}
+
+func TestGrow(t *testing.T) {
+	b := New(0)
+	if b.Len() != 0 {
+		t.Fatalf("Len() = %d, expected 0", b.Len())
+	}
+	b.Set(5)
+	if b.Len() != 64 {
+		t.Fatalf("Len() = %d, expected 64", b.Len())
+	}
+	// grows to the needed words when doubling is not enough
+	b.Set(1000)
+	if b.Len() != 1024 {
+		t.Fatalf("Len() = %d, expected 1024", b.Len())
+	}
+	// at least doubles the words
+	b.Toggle(1024)
+	if b.Len() != 2048 {
+		t.Fatalf("Len() = %d, expected 2048", b.Len())
+	}
+	for _, n := range []uint{5, 1000, 1024} {
+		if !b.Test(n) {
+			t.Fatalf("bit %d is lost after grow", n)
+		}
+	}
+	if b.Count() != 3 {
+		t.Fatalf("Count() = %d, expected 3", b.Count())
+	}
+	// Clear and Test out of range never grow
+	b.Clear(5000)
+	if b.Test(5000) || b.Len() != 2048 {
+		t.Fatalf("Clear or Test out of range changed the BitSet, Len() = %d", b.Len())
+	}
+}
+
+func TestBytes(t *testing.T) {
+	b := New(128)
+	for _, n := range []uint{0, 9, 64, 127} {
+		b.Set(n)
+	}
+	data := b.Bytes()
+	expected := make([]byte, 16)
+	expected[0], expected[1], expected[8], expected[15] = 0x01, 0x02, 0x01, 0x80
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("Bytes() = %x, expected %x", data, expected)
+	}
+	decoded := FromBytes(data)
+	if decoded.Len() != b.Len() || !bytes.Equal(decoded.Bytes(), data) {
+		t.Fatalf("FromBytes(Bytes()) = %x, expected %x", decoded.Bytes(), data)
+	}
+	// a partial word is padded with zeros
+	partial := FromBytes([]byte{0x01, 0x80})
+	if partial.Len() != 64 || !partial.Test(0) || !partial.Test(15) || partial.Count() != 2 {
+		t.Fatalf("FromBytes of a partial word = %x", partial.Bytes())
+	}
+}
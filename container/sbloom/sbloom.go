@@ -0,0 +1,156 @@
+// Package sbloom
+// MIT License
+//
+// # Copyright (c) 2024 sugar
+// Author https://github.com/go-fox/sugar
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package sbloom
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+
+	"github.com/go-fox/sugar/container/sbitset"
+)
+
+// headerSize is the size of m, k and n in the binary form.
+const headerSize = 24
+
+// BloomFilter is a probabilistic set, Test never returns false for added data,
+// but may return true for data never added. It is not safe for concurrent use.
+type BloomFilter struct {
+	bits *sbitset.BitSet
+	m    uint // number of bits
+	k    uint // number of hash functions
+	n    uint // number of added data
+}
+
+// New new a BloomFilter with the optimal number of bits and hash functions for
+// `expectedN` data at `falsePositiveRate`. The rate is 0.01 if it is not in (0, 1).
+//
+//	@param expectedN uint
+//	@param falsePositiveRate float64
+//	@return *BloomFilter
+//	@player
+func New(expectedN uint, falsePositiveRate float64) *BloomFilter {
+	n := float64(max(expectedN, 1))
+	p := falsePositiveRate
+	if p <= 0 || p >= 1 {
+		p = 0.01
+	}
+	m := uint(math.Ceil(-n * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := uint(math.Round(float64(m) / n * math.Ln2))
+	return &BloomFilter{
+		bits: sbitset.New(m),
+		m:    m,
+		k:    max(k, 1),
+	}
+}
+
+// Add adds data to the filter
+//
+//	@receiver f
+//	@param data []byte
+//	@player
+func (f *BloomFilter) Add(data []byte) {
+	h1, h2 := hashes(data)
+	for i := uint(0); i < f.k; i++ {
+		f.bits.Set(f.location(h1, h2, i))
+	}
+	f.n++
+}
+
+// Test reports whether data may have been added, false means it was definitely not added
+//
+//	@receiver f
+//	@param data []byte
+//	@return bool
+//	@player
+func (f *BloomFilter) Test(data []byte) bool {
+	h1, h2 := hashes(data)
+	for i := uint(0); i < f.k; i++ {
+		if !f.bits.Test(f.location(h1, h2, i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// FalsePositiveRate returns the estimated false positive rate for the data added so far
+//
+//	@receiver f
+//	@return float64
+//	@player
+func (f *BloomFilter) FalsePositiveRate() float64 {
+	k, m, n := float64(f.k), float64(f.m), float64(f.n)
+	return math.Pow(1-math.Exp(-k*n/m), k)
+}
+
+// MarshalBinary implements the interface MarshalBinary for encoding.BinaryMarshaler.
+//
+//	@receiver f
+//	@return []byte
+//	@return error
+//	@player
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	bitsData := f.bits.Bytes()
+	data := make([]byte, headerSize, headerSize+len(bitsData))
+	binary.BigEndian.PutUint64(data[0:], uint64(f.m))
+	binary.BigEndian.PutUint64(data[8:], uint64(f.k))
+	binary.BigEndian.PutUint64(data[16:], uint64(f.n))
+	return append(data, bitsData...), nil
+}
+
+// UnmarshalBinary implements the interface UnmarshalBinary for encoding.BinaryUnmarshaler.
+//
+//	@receiver f
+//	@param data []byte
+//	@return error
+//	@player
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < headerSize {
+		return errors.New("sbloom.UnmarshalBinary: data too short")
+	}
+	m := uint(binary.BigEndian.Uint64(data[0:]))
+	k := uint(binary.BigEndian.Uint64(data[8:]))
+	if m == 0 || k == 0 {
+		return errors.New("sbloom.UnmarshalBinary: invalid header")
+	}
+	f.m, f.k = m, k
+	f.n = uint(binary.BigEndian.Uint64(data[16:]))
+	f.bits = sbitset.FromBytes(data[headerSize:])
+	return nil
+}
+
+// location returns the i-th bit location, by double hashing h1 + i*h2.
+func (f *BloomFilter) location(h1, h2 uint64, i uint) uint {
+	return uint((h1 + uint64(i)*h2) % uint64(f.m))
+}
+
+func hashes(data []byte) (uint64, uint64) {
+	h1 := fnv.New64a()
+	_, _ = h1.Write(data)
+	h2 := fnv.New64()
+	_, _ = h2.Write(data)
+	// h2 must be odd, so that the locations do not collapse
+	return h1.Sum64(), h2.Sum64() | 1
+}
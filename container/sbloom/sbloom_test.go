@@ -0,0 +1,73 @@
+package sbloom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"testing"
+)
+
+func TestNoFalseNegatives(t *testing.T) {
+	f := New(1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	for i := 0; i < 1000; i++ {
+		if !f.Test([]byte(strconv.Itoa(i))) {
+			t.Fatalf("Test(%d) = false after Add", i)
+		}
+	}
+	falsePositives := 0
+	for i := 1000; i < 11000; i++ {
+		if f.Test([]byte(strconv.Itoa(i))) {
+			falsePositives++
+		}
+	}
+	// the expected rate is 0.01, allow generous slack for the hash distribution
+	if rate := float64(falsePositives) / 10000; rate > 0.05 {
+		t.Fatalf("false positive rate %v, expected about 0.01", rate)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	f := New(100, 0.01)
+	for i := 0; i < 50; i++ {
+		f.Add([]byte(strconv.Itoa(i)))
+	}
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := binary.BigEndian.Uint64(data[0:]); m != uint64(f.m) {
+		t.Fatalf("header m = %d, expected %d", m, f.m)
+	}
+	if k := binary.BigEndian.Uint64(data[8:]); k != uint64(f.k) {
+		t.Fatalf("header k = %d, expected %d", k, f.k)
+	}
+	if n := binary.BigEndian.Uint64(data[16:]); n != 50 {
+		t.Fatalf("header n = %d, expected 50", n)
+	}
+	if !bytes.Equal(data[headerSize:], f.bits.Bytes()) {
+		t.Fatal("the bits after the header are not BitSet.Bytes")
+	}
+
+	var decoded BloomFilter
+	if err = decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.m != f.m || decoded.k != f.k || decoded.n != f.n {
+		t.Fatalf("decoded m, k, n = %d, %d, %d, expected %d, %d, %d", decoded.m, decoded.k, decoded.n, f.m, f.k, f.n)
+	}
+	for i := 0; i < 50; i++ {
+		if !decoded.Test([]byte(strconv.Itoa(i))) {
+			t.Fatalf("decoded Test(%d) = false", i)
+		}
+	}
+
+	if err = decoded.UnmarshalBinary(data[:headerSize-1]); err == nil {
+		t.Fatal("UnmarshalBinary of short data succeeded")
+	}
+	if err = decoded.UnmarshalBinary(make([]byte, headerSize)); err == nil {
+		t.Fatal("UnmarshalBinary of a zero header succeeded")
+	}
+}
@@ -0,0 +1,120 @@
+// Package sexpiry
+// MIT License
+//
+// # Copyright (c) 2024 sugar
+// Author https://github.com/go-fox/sugar
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package sexpiry
+
+import (
+	"time"
+
+	"github.com/go-fox/sugar/container/satomic"
+)
+
+// ExpiringValue is a value that expires after a duration, such as a cached token.
+// It is safe for concurrent use, the zero value is an empty ExpiringValue.
+type ExpiringValue[T any] struct {
+	v satomic.Value[*entry[T]]
+}
+
+type entry[T any] struct {
+	value    T
+	deadline time.Time
+}
+
+// New new an empty ExpiringValue
+//
+//	@return *ExpiringValue[T]
+//	@player
+func New[T any]() *ExpiringValue[T] {
+	return &ExpiringValue[T]{}
+}
+
+// Set stores the value, it expires after `ttl`.
+//
+//	@receiver e
+//	@param v T
+//	@param ttl time.Duration
+//	@player
+func (e *ExpiringValue[T]) Set(v T, ttl time.Duration) {
+	e.v.Store(&entry[T]{value: v, deadline: time.Now().Add(ttl)})
+}
+
+// Get returns the value, ok is false if the value has expired or has never been set.
+//
+//	@receiver e
+//	@return T
+//	@return bool
+//	@player
+func (e *ExpiringValue[T]) Get() (T, bool) {
+	cur := e.load()
+	if cur == nil || !time.Now().Before(cur.deadline) {
+		var zero T
+		return zero, false
+	}
+	return cur.value, true
+}
+
+// TTL returns the remaining time to live, it is 0 if the value has expired or has never been set.
+//
+//	@receiver e
+//	@return time.Duration
+//	@player
+func (e *ExpiringValue[T]) TTL() time.Duration {
+	cur := e.load()
+	if cur == nil {
+		return 0
+	}
+	return max(time.Until(cur.deadline), 0)
+}
+
+// IsExpired reports whether the value has expired or has never been set.
+//
+//	@receiver e
+//	@return bool
+//	@player
+func (e *ExpiringValue[T]) IsExpired() bool {
+	_, ok := e.Get()
+	return !ok
+}
+
+// Reset keeps the value and sets it to expire after `ttl` from now, even if it has expired.
+// It does nothing if the value has never been set.
+//
+//	@receiver e
+//	@param ttl time.Duration
+//	@player
+func (e *ExpiringValue[T]) Reset(ttl time.Duration) {
+	for {
+		cur := e.load()
+		if cur == nil {
+			return
+		}
+		next := &entry[T]{value: cur.value, deadline: time.Now().Add(ttl)}
+		if e.v.CompareAndSwap(cur, next) {
+			return
+		}
+	}
+}
+
+func (e *ExpiringValue[T]) load() *entry[T] {
+	return e.v.LoadDefault(nil)
+}
@@ -0,0 +1,65 @@
+package smap
+
+import (
+	"sort"
+	"strings"
+)
+
+const flattenSeparator = "."
+
+// Flatten flattens a nested map into a flat map with dotted keys, such as {"a": {"b": 1}} to {"a.b": 1}.
+// The leaf values which are not of type V are ignored.
+//
+//	@param nested map[string]interface{}
+//	@return map[string]V
+//	@player
+func Flatten[V any](nested map[string]interface{}) map[string]V {
+	result := make(map[string]V)
+	flatten("", nested, result)
+	return result
+}
+
+func flatten[V any](prefix string, nested map[string]interface{}, result map[string]V) {
+	for k, v := range nested {
+		key := prefix + k
+		if child, ok := v.(map[string]interface{}); ok {
+			flatten(key+flattenSeparator, child, result)
+			continue
+		}
+		if val, ok := v.(V); ok {
+			result[key] = val
+		}
+	}
+}
+
+// Unflatten is the reverse of Flatten, it expands the dotted keys into nested maps.
+// If a key is both a leaf and the prefix of another key, such as "a" and "a.b", the nested map wins.
+//
+//	@param flat map[string]V
+//	@return map[string]interface{}
+//	@player
+func Unflatten[V any](flat map[string]V) map[string]interface{} {
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	result := make(map[string]interface{})
+	for _, key := range keys {
+		parts := strings.Split(key, flattenSeparator)
+		node := result
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				node[part] = child
+			}
+			node = child
+		}
+		leaf := parts[len(parts)-1]
+		if _, ok := node[leaf].(map[string]interface{}); !ok {
+			node[leaf] = flat[key]
+		}
+	}
+	return result
+}
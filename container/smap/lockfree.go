@@ -0,0 +1,109 @@
+package smap
+
+import "sync"
+
+// LockFreeMap is a hash map backed by sync.Map, it is faster than a safe Map for read-heavy workloads.
+// It has the same Get, Set, Del, Iterator, Size, Keys and Values methods as Map.
+type LockFreeMap[K comparable, V any] struct {
+	data sync.Map
+}
+
+// NewLockFree new and returns an empty lock free hash map.
+//
+//	@return *LockFreeMap[K
+//	@return V]
+//	@player
+func NewLockFree[K comparable, V any]() *LockFreeMap[K, V] {
+	return &LockFreeMap[K, V]{}
+}
+
+// Iterator iterates the hash map readonly with custom callback function `f`.
+//
+//	@receiver s
+//	@param f func(key K, value V) bool returns true, then it continues iterating; or false to stop.
+//	@player
+func (s *LockFreeMap[K, V]) Iterator(f func(key K, value V) bool) {
+	s.data.Range(func(key, value any) bool {
+		return f(key.(K), cast[V](value))
+	})
+}
+
+// Set sets key-value to the hash map.
+//
+//	@receiver s
+//	@param key K
+//	@param value V
+//	@player
+func (s *LockFreeMap[K, V]) Set(key K, value V) {
+	s.data.Store(key, value)
+}
+
+// Get returns the value by given `key`.
+//
+//	@receiver s
+//	@param key K
+//	@return v
+//	@return ok
+//	@player
+func (s *LockFreeMap[K, V]) Get(key K) (v V, ok bool) {
+	val, ok := s.data.Load(key)
+	return cast[V](val), ok
+}
+
+// Del delete value by `key`
+//
+//	@receiver s
+//	@param key K
+//	@player
+func (s *LockFreeMap[K, V]) Del(key K) {
+	s.data.Delete(key)
+}
+
+// Size returns the number of elements in the hash map, it iterates all elements.
+//
+//	@receiver s
+//	@return int
+//	@player
+func (s *LockFreeMap[K, V]) Size() int {
+	size := 0
+	s.data.Range(func(_, _ any) bool {
+		size++
+		return true
+	})
+	return size
+}
+
+// Keys returns all keys of the hash map as a slice.
+//
+//	@receiver s
+//	@return []K
+//	@player
+func (s *LockFreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0)
+	s.data.Range(func(key, _ any) bool {
+		keys = append(keys, key.(K))
+		return true
+	})
+	return keys
+}
+
+// Values returns all values of the hash map as a slice.
+//
+//	@receiver s
+//	@return []V
+//	@player
+func (s *LockFreeMap[K, V]) Values() []V {
+	values := make([]V, 0)
+	s.data.Range(func(_, value any) bool {
+		values = append(values, cast[V](value))
+		return true
+	})
+	return values
+}
+
+// cast converts a value loaded from sync.Map to V, a stored nil interface value, such as a nil error,
+// cannot be asserted to V and is returned as the zero value.
+func cast[V any](v any) V {
+	val, _ := v.(V)
+	return val
+}
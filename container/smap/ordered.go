@@ -0,0 +1,201 @@
+package smap
+
+import (
+	"container/list"
+	"fmt"
+
+	"github.com/go-fox/sugar/internal/json"
+	"github.com/go-fox/sugar/internal/rwmutex"
+)
+
+// OrderedMap is a hash map preserving insertion order, backed by a map for lookup
+// and a doubly linked list for ordered traversal.
+type OrderedMap[K comparable, V any] struct {
+	mu    *rwmutex.RWMutex
+	data  map[K]*list.Element
+	order *list.List
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// NewOrdered new and returns an empty ordered hash map.
+//
+//	@param safe ...bool is it used during concurrency
+//	@return *OrderedMap[K
+//	@return V]
+//	@player
+func NewOrdered[K comparable, V any](safe ...bool) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		mu:    rwmutex.New(safe...),
+		data:  make(map[K]*list.Element),
+		order: list.New(),
+	}
+}
+
+// Iterator iterates the hash map readonly in insertion order with custom callback function `f`.
+//
+//	@receiver s
+//	@param f func(key K, value V) bool returns true, then it continues iterating; or false to stop.
+//	@player
+func (s *OrderedMap[K, V]) Iterator(f func(key K, value V) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for e := s.order.Front(); e != nil; e = e.Next() {
+		entry := e.Value.(*orderedEntry[K, V])
+		if !f(entry.key, entry.value) {
+			return
+		}
+	}
+}
+
+// Set sets key-value to the hash map, an existing key keeps its position.
+//
+//	@receiver s
+//	@param key K
+//	@param value V
+//	@player
+func (s *OrderedMap[K, V]) Set(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(key, value)
+}
+
+// Get returns the value by given `key`.
+//
+//	@receiver s
+//	@param key K
+//	@return v
+//	@return ok
+//	@player
+func (s *OrderedMap[K, V]) Get(key K) (v V, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	e, ok := s.data[key]
+	if !ok {
+		return
+	}
+	return e.Value.(*orderedEntry[K, V]).value, true
+}
+
+// Del delete value by `key`
+//
+//	@receiver s
+//	@param key K
+//	@player
+func (s *OrderedMap[K, V]) Del(key K) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.data[key]; ok {
+		s.order.Remove(e)
+		delete(s.data, key)
+	}
+}
+
+// Size returns the number of elements in the hash map.
+//
+//	@receiver s
+//	@return int
+//	@player
+func (s *OrderedMap[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// Keys returns all keys of the hash map in insertion order.
+//
+//	@receiver s
+//	@return []K
+//	@player
+func (s *OrderedMap[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]K, 0, len(s.data))
+	for e := s.order.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.(*orderedEntry[K, V]).key)
+	}
+	return keys
+}
+
+// Values returns all values of the hash map in insertion order.
+//
+//	@receiver s
+//	@return []V
+//	@player
+func (s *OrderedMap[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values := make([]V, 0, len(s.data))
+	for e := s.order.Front(); e != nil; e = e.Next() {
+		values = append(values, e.Value.(*orderedEntry[K, V]).value)
+	}
+	return values
+}
+
+// MarshalJSON implements the interface MarshalJSON for json.Marshal.
+// The hash map is marshaled to an array of [key, value] pairs to preserve the order.
+//
+//	@receiver s
+//	@return []byte
+//	@return error
+//	@player
+func (s *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	pairs := make([][2]any, 0, len(s.data))
+	for e := s.order.Front(); e != nil; e = e.Next() {
+		entry := e.Value.(*orderedEntry[K, V])
+		pairs = append(pairs, [2]any{entry.key, entry.value})
+	}
+	return json.Marshal(pairs)
+}
+
+// UnmarshalJSON implements the interface UnmarshalJSON for json.Unmarshal.
+//
+//	@receiver s
+//	@param data []byte an array of [key, value] pairs
+//	@return error
+//	@player
+func (s *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if s.mu == nil {
+		s.mu = rwmutex.New()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data == nil {
+		s.data = make(map[K]*list.Element)
+		s.order = list.New()
+	}
+	var pairs [][]json.RawMessage
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return err
+	}
+	for i, pair := range pairs {
+		if len(pair) != 2 {
+			return fmt.Errorf("smap.OrderedMap: pair %d has %d elements, expected 2", i, len(pair))
+		}
+		var (
+			key   K
+			value V
+		)
+		if err := json.Unmarshal(pair[0], &key); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(pair[1], &value); err != nil {
+			return err
+		}
+		s.set(key, value)
+	}
+	return nil
+}
+
+func (s *OrderedMap[K, V]) set(key K, value V) {
+	if e, ok := s.data[key]; ok {
+		e.Value.(*orderedEntry[K, V]).value = value
+		return
+	}
+	s.data[key] = s.order.PushBack(&orderedEntry[K, V]{key: key, value: value})
+}
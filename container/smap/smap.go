@@ -24,6 +24,9 @@
 package smap
 
 import (
+	"reflect"
+	"sort"
+
 	"github.com/go-fox/sugar/internal/rwmutex"
 )
 
@@ -40,6 +43,37 @@ func New[K comparable, V any](safe ...bool) *Map[K, V] {
 	}
 }
 
+// NewFromMap returns a hash map using `data` as the underlying data without copying.
+// Accessing `data` directly after the call is not concurrent safe, use NewFromMapCopy for isolation.
+//
+//	@param data map[K]V
+//	@param safe ...bool is it used during concurrency
+//	@return *Map[K, V]
+//	@player
+func NewFromMap[K comparable, V any](data map[K]V, safe ...bool) *Map[K, V] {
+	if data == nil {
+		data = make(map[K]V)
+	}
+	return &Map[K, V]{
+		mu:   rwmutex.New(safe...),
+		data: data,
+	}
+}
+
+// NewFromMapCopy returns a hash map with a shallow copy of `data`.
+//
+//	@param data map[K]V
+//	@param safe ...bool is it used during concurrency
+//	@return *Map[K, V]
+//	@player
+func NewFromMapCopy[K comparable, V any](data map[K]V, safe ...bool) *Map[K, V] {
+	m := make(map[K]V, len(data))
+	for k, v := range data {
+		m[k] = v
+	}
+	return NewFromMap(m, safe...)
+}
+
 // Map wraps map type `map[K comparable]V any` and provides more map features.
 type Map[K comparable, V any] struct {
 	mu   *rwmutex.RWMutex
@@ -61,6 +95,29 @@ func (s *Map[K, V]) Iterator(f func(key K, value V) bool) {
 	}
 }
 
+// SortedIterator iterates the hash map readonly in the key order defined by `less` with custom callback function `f`.
+//
+//	@receiver s
+//	@param less func(a, b K) bool reports whether a must sort before b
+//	@param f func(key K, value V) bool returns true, then it continues iterating; or false to stop.
+//	@player
+func (s *Map[K, V]) SortedIterator(less func(a, b K) bool, f func(key K, value V) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]K, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return less(keys[i], keys[j])
+	})
+	for _, k := range keys {
+		if !f(k, s.data[k]) {
+			return
+		}
+	}
+}
+
 // CopyMap returns a shallow copy of the underlying data of the hash map.
 //
 //	@receiver s
@@ -148,6 +205,86 @@ func (s *Map[K, V]) Get(key K) (v V, ok bool) {
 	return
 }
 
+// UpdateIfPresent sets the value of `key` to the result of `f` applied to the current value,
+// if the key exists.
+//
+//	@receiver s
+//	@param key K
+//	@param f func(V) V
+//	@return bool whether the key exists
+//	@player
+func (s *Map[K, V]) UpdateIfPresent(key K, f func(V) V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.data[key]
+	if !ok {
+		return false
+	}
+	s.data[key] = f(v)
+	return true
+}
+
+// Compute sets the value of `key` to the result of `f`, which receives the current value
+// and whether the key exists, and returns the new value.
+//
+//	@receiver s
+//	@param key K
+//	@param f func(v V, ok bool) V
+//	@return V
+//	@player
+func (s *Map[K, V]) Compute(key K, f func(v V, ok bool) V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.data[key]
+	v = f(v, ok)
+	s.data[key] = v
+	return v
+}
+
+// ComputeIfPresent sets the value of `key` to the result of `f`, if the key exists.
+// The key is deleted if `f` returns the zero value of V.
+//
+//	@receiver s
+//	@param key K
+//	@param f func(key K, v V) V
+//	@return V the new value
+//	@return bool whether the key exists after the call
+//	@player
+func (s *Map[K, V]) ComputeIfPresent(key K, f func(key K, v V) V) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.data[key]
+	if !ok {
+		return v, false
+	}
+	v = f(key, v)
+	if reflect.ValueOf(&v).Elem().IsZero() {
+		delete(s.data, key)
+		return v, false
+	}
+	s.data[key] = v
+	return v, true
+}
+
+// ComputeIfAbsent sets the value of `key` to the result of `f` if the key does not exist,
+// and returns the value of the key.
+//
+//	@receiver s
+//	@param key K
+//	@param f func(key K) V
+//	@return V
+//	@player
+func (s *Map[K, V]) ComputeIfAbsent(key K, f func(key K) V) V {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.data[key]; ok {
+		return v
+	}
+	v := f(key)
+	s.data[key] = v
+	return v
+}
+
 // Del delete value by `key`
 //
 //	@receiver s
@@ -159,3 +296,126 @@ func (s *Map[K, V]) Del(key K) {
 	defer s.mu.Unlock()
 	delete(s.data, key)
 }
+
+// Size returns the number of elements in the hash map.
+//
+//	@receiver s
+//	@return int
+//	@player
+func (s *Map[K, V]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+// Keys returns all keys of the hash map as a slice.
+//
+//	@receiver s
+//	@return []K
+//	@player
+func (s *Map[K, V]) Keys() []K {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]K, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns all values of the hash map as a slice.
+//
+//	@receiver s
+//	@return []V
+//	@player
+func (s *Map[K, V]) Values() []V {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values := make([]V, 0, len(s.data))
+	for _, v := range s.data {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Diff compares two hash maps, `added` contains the entries of b whose keys are not in a,
+// `removed` contains the entries of a whose keys are not in b, and `changed` contains the keys
+// in both whose values are not equal by `eq`, each holding [old value in a, new value in b].
+//
+//	@param a *Map[K, V]
+//	@param b *Map[K, V]
+//	@param eq func(V, V) bool
+//	@return added map[K]V
+//	@return removed map[K]V
+//	@return changed map[K][2]V
+//	@player
+func Diff[K comparable, V any](a, b *Map[K, V], eq func(V, V) bool) (added, removed map[K]V, changed map[K][2]V) {
+	// copy b first, so that the read locks of both maps are never held at once
+	bData := b.CopyMap()
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	added, removed, changed = make(map[K]V), make(map[K]V), make(map[K][2]V)
+	for k, av := range a.data {
+		bv, ok := bData[k]
+		if !ok {
+			removed[k] = av
+			continue
+		}
+		if !eq(av, bv) {
+			changed[k] = [2]V{av, bv}
+		}
+	}
+	for k, bv := range bData {
+		if _, ok := a.data[k]; !ok {
+			added[k] = bv
+		}
+	}
+	return added, removed, changed
+}
+
+// GroupBy partitions the entries of the hash map by the key returned by `group`,
+// such as grouping users by their department.
+//
+//	@param m *Map[K, V]
+//	@param group func(K, V) GK
+//	@return map[GK]map[K]V
+//	@player
+func GroupBy[K, GK comparable, V any](m *Map[K, V], group func(K, V) GK) map[GK]map[K]V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	result := make(map[GK]map[K]V)
+	for k, v := range m.data {
+		gk := group(k, v)
+		entries, ok := result[gk]
+		if !ok {
+			entries = make(map[K]V)
+			result[gk] = entries
+		}
+		entries[k] = v
+	}
+	return result
+}
+
+// Equal reports whether two hash maps have the same keys and `eq` returns true for all corresponding values.
+//
+//	@param a *Map[K, V]
+//	@param b *Map[K, V]
+//	@param eq func(V, V) bool
+//	@return bool
+//	@player
+func Equal[K comparable, V any](a, b *Map[K, V], eq func(V, V) bool) bool {
+	// copy b first, so that the read locks of both maps are never held at once
+	bData := b.CopyMap()
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if len(a.data) != len(bData) {
+		return false
+	}
+	for k, av := range a.data {
+		bv, ok := bData[k]
+		if !ok || !eq(av, bv) {
+			return false
+		}
+	}
+	return true
+}
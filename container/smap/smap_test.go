@@ -0,0 +1,34 @@
+package smap
+
+import "testing"
+
+const benchmarkKeys = 1024
+
+// benchmarkReadHeavy runs 95% reads and 5% writes in parallel.
+func benchmarkReadHeavy(b *testing.B, get func(int) (int, bool), set func(int, int)) {
+	for i := 0; i < benchmarkKeys; i++ {
+		set(i, i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%20 == 0 {
+				set(i%benchmarkKeys, i)
+			} else {
+				get(i % benchmarkKeys)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkMapReadHeavy(b *testing.B) {
+	m := New[int, int](true)
+	benchmarkReadHeavy(b, m.Get, m.Set)
+}
+
+func BenchmarkLockFreeMapReadHeavy(b *testing.B) {
+	m := NewLockFree[int, int]()
+	benchmarkReadHeavy(b, m.Get, m.Set)
+}
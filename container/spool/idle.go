@@ -0,0 +1,110 @@
+package spool
+
+import (
+	"sync"
+	"time"
+)
+
+// idlePool keeps the objects returned to the pool with their last Put time,
+// and cleans up the objects that have been idle for too long.
+type idlePool[T any] struct {
+	mu      sync.Mutex
+	items   []idleItem[T]
+	timeout time.Duration
+	cleanup func(T)
+	closed  bool
+	done    chan struct{}
+	once    sync.Once
+}
+
+type idleItem[T any] struct {
+	value   T
+	putTime time.Time
+}
+
+func newIdlePool[T any](timeout time.Duration, cleanup func(T)) *idlePool[T] {
+	p := &idlePool[T]{
+		timeout: timeout,
+		cleanup: cleanup,
+		done:    make(chan struct{}),
+	}
+	go p.run(max(timeout/10, time.Millisecond))
+	return p
+}
+
+// get returns the most recently put object.
+func (p *idlePool[T]) get() (x T, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.items) == 0 {
+		return
+	}
+	last := len(p.items) - 1
+	x = p.items[last].value
+	p.items[last] = idleItem[T]{}
+	p.items = p.items[:last]
+	return x, true
+}
+
+// put keeps the object, it returns false if the idle pool is closed.
+func (p *idlePool[T]) put(x T) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return false
+	}
+	p.items = append(p.items, idleItem[T]{value: x, putTime: time.Now()})
+	return true
+}
+
+func (p *idlePool[T]) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.evict(time.Now().Add(-p.timeout))
+		case <-p.done:
+			return
+		}
+	}
+}
+
+// evict cleans up the objects put before the deadline.
+func (p *idlePool[T]) evict(deadline time.Time) {
+	p.mu.Lock()
+	// items are ordered by put time, the oldest ones are at the front
+	n := 0
+	for n < len(p.items) && !p.items[n].putTime.After(deadline) {
+		n++
+	}
+	expired := make([]T, n)
+	for i := 0; i < n; i++ {
+		expired[i] = p.items[i].value
+	}
+	remain := copy(p.items, p.items[n:])
+	// zero the tail, so that the backing array does not keep the expired objects reachable
+	for i := remain; i < len(p.items); i++ {
+		p.items[i] = idleItem[T]{}
+	}
+	p.items = p.items[:remain]
+	p.mu.Unlock()
+	if p.cleanup == nil {
+		return
+	}
+	// cleanup is called outside the lock, it may be slow such as closing a connection
+	for _, x := range expired {
+		p.cleanup(x)
+	}
+}
+
+// close stops the background goroutine and cleans up all idle objects.
+func (p *idlePool[T]) close() {
+	p.once.Do(func() {
+		p.mu.Lock()
+		p.closed = true
+		p.mu.Unlock()
+		close(p.done)
+		p.evict(time.Now().Add(time.Hour))
+	})
+}
@@ -1,11 +1,15 @@
 package spool
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 // Pool is an object pooling
 type Pool[T any] struct {
 	pool  *sync.Pool
 	reset func(T)
+	idle  *idlePool[T]
 }
 
 // New news an object pool
@@ -38,9 +42,30 @@ func New[T any](factory func() T, reset ...func(T)) *Pool[T] {
 //	@receiver v
 //	@return T
 func (v *Pool[T]) Get() T {
+	if v.idle != nil {
+		if x, ok := v.idle.get(); ok {
+			return x
+		}
+	}
 	return v.pool.Get().(T)
 }
 
+// TryGet 获取, ok is false instead of panic if the object taken from the underlying sync.Pool is not a T,
+// the mismatched object is dropped.
+//
+//	@receiver v
+//	@return T
+//	@return bool
+func (v *Pool[T]) TryGet() (T, bool) {
+	if v.idle != nil {
+		if x, ok := v.idle.get(); ok {
+			return x, true
+		}
+	}
+	x, ok := v.pool.Get().(T)
+	return x, ok
+}
+
 // Put 归还
 //
 //	@receiver v
@@ -49,5 +74,46 @@ func (v *Pool[T]) Put(x T) {
 	if v.reset != nil {
 		v.reset(x)
 	}
+	if v.idle != nil && v.idle.put(x) {
+		return
+	}
 	v.pool.Put(x)
 }
+
+// WithReset replaces the reset function called by Put, a nil `f` removes it.
+// It should be called before the pool is used.
+//
+//	@receiver v
+//	@param f func(T)
+//	@return *Pool[T]
+func (v *Pool[T]) WithReset(f func(T)) *Pool[T] {
+	v.reset = f
+	return v
+}
+
+// WithIdleTimeout keeps the objects returned by Put, and calls `cleanup` on the objects that have been
+// idle for longer than `d`, such as closing idle connections. A background goroutine checks every d/10
+// until Close is called. A nil `cleanup` drops the idle objects without a callback.
+// It should be called before the pool is used.
+//
+//	@receiver v
+//	@param d time.Duration
+//	@param cleanup func(T)
+//	@return *Pool[T]
+func (v *Pool[T]) WithIdleTimeout(d time.Duration, cleanup func(T)) *Pool[T] {
+	if v.idle != nil {
+		v.idle.close()
+	}
+	v.idle = newIdlePool(d, cleanup)
+	return v
+}
+
+// Close stops the idle timeout goroutine and calls the cleanup callback on all idle objects,
+// the objects put after Close are no longer tracked.
+//
+//	@receiver v
+func (v *Pool[T]) Close() {
+	if v.idle != nil {
+		v.idle.close()
+	}
+}
@@ -0,0 +1,124 @@
+package spool
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTryGet(t *testing.T) {
+	p := New(func() int { return 1 })
+	if x, ok := p.TryGet(); !ok || x != 1 {
+		t.Fatalf("TryGet() = %v, %v, expected 1, true", x, ok)
+	}
+	p.pool.New = func() any { return "incompatible" }
+	if x, ok := p.TryGet(); ok || x != 0 {
+		t.Fatalf("TryGet() = %v, %v, expected 0, false", x, ok)
+	}
+}
+
+// cleanupRecorder records the objects passed to the cleanup callback.
+type cleanupRecorder struct {
+	mu      sync.Mutex
+	cleaned []int
+}
+
+func (r *cleanupRecorder) cleanup(x int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleaned = append(r.cleaned, x)
+}
+
+func (r *cleanupRecorder) get() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int{}, r.cleaned...)
+}
+
+func idleLen[T any](p *Pool[T]) int {
+	p.idle.mu.Lock()
+	defer p.idle.mu.Unlock()
+	return len(p.idle.items)
+}
+
+func TestIdleEvict(t *testing.T) {
+	var r cleanupRecorder
+	// the timeout is long enough that the background goroutine never evicts during the test
+	p := newIdlePool(time.Hour, r.cleanup)
+	now := time.Now()
+	for i, age := range []time.Duration{3 * time.Minute, 2 * time.Minute, 0} {
+		p.put(i + 1)
+		p.items[i].putTime = now.Add(-age)
+	}
+
+	p.evict(now.Add(-time.Minute))
+	if cleaned := r.get(); !reflect.DeepEqual(cleaned, []int{1, 2}) {
+		t.Fatalf("cleaned %v, expected [1 2] in put order", cleaned)
+	}
+	if tail := p.items[len(p.items):cap(p.items)]; !reflect.DeepEqual(tail, make([]idleItem[int], len(tail))) {
+		t.Fatalf("the backing array still references evicted items %v", tail)
+	}
+	p.evict(now.Add(-time.Minute))
+	if cleaned := r.get(); len(cleaned) != 2 {
+		t.Fatalf("cleaned %v, expected each expired object exactly once", cleaned)
+	}
+	if x, ok := p.get(); !ok || x != 3 {
+		t.Fatalf("get() = %v, %v, expected the fresh object 3", x, ok)
+	}
+	if x, ok := p.get(); ok {
+		t.Fatalf("get() = %v after all objects are taken or evicted", x)
+	}
+
+	p.put(4)
+	p.put(5)
+	p.close()
+	p.close()
+	if cleaned := r.get(); !reflect.DeepEqual(cleaned, []int{1, 2, 4, 5}) {
+		t.Fatalf("cleaned %v, expected close to clean up the idle objects once", cleaned)
+	}
+	if p.put(6) {
+		t.Fatal("put() after close kept the object")
+	}
+}
+
+func TestWithIdleTimeout(t *testing.T) {
+	var r cleanupRecorder
+	p := New(func() int { return 0 }).WithIdleTimeout(10*time.Millisecond, r.cleanup)
+	p.Put(1)
+	deadline := time.Now().Add(time.Second)
+	for len(r.get()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if cleaned := r.get(); !reflect.DeepEqual(cleaned, []int{1}) {
+		t.Fatalf("cleaned %v, expected the idle object 1", cleaned)
+	}
+	if x := p.Get(); x != 0 {
+		t.Fatalf("Get() = %v after eviction, expected a new object", x)
+	}
+
+	p.Put(2)
+	p.Close()
+	if cleaned := r.get(); !reflect.DeepEqual(cleaned, []int{1, 2}) {
+		t.Fatalf("cleaned %v, expected Close to clean up the idle object 2", cleaned)
+	}
+	// Put after Close goes to the underlying sync.Pool and is no longer tracked
+	p.Put(3)
+	if n := idleLen(p); n != 0 {
+		t.Fatalf("%d idle objects after Close", n)
+	}
+	if cleaned := r.get(); len(cleaned) != 2 {
+		t.Fatalf("cleaned %v, expected no cleanup for Put after Close", cleaned)
+	}
+}
+
+func TestWithIdleTimeoutNilCleanup(t *testing.T) {
+	p := New(func() int { return 0 }).WithIdleTimeout(time.Millisecond, nil)
+	p.Put(1)
+	time.Sleep(20 * time.Millisecond)
+	p.Put(2)
+	p.Close()
+	if n := idleLen(p); n != 0 {
+		t.Fatalf("%d idle objects after Close", n)
+	}
+}
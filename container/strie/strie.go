@@ -0,0 +1,198 @@
+// Package strie
+// MIT License
+//
+// # Copyright (c) 2024 sugar
+// Author https://github.com/go-fox/sugar
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+package strie
+
+import (
+	"sort"
+
+	"github.com/go-fox/sugar/internal/rwmutex"
+)
+
+// Trie is a prefix tree of strings.
+type Trie struct {
+	mu   *rwmutex.RWMutex
+	root *node
+	size int
+}
+
+type node struct {
+	children map[rune]*node
+	end      bool
+}
+
+// New new and returns an empty trie.
+//
+//	@param safe ...bool is it used during concurrency
+//	@return *Trie
+//	@player
+func New(safe ...bool) *Trie {
+	return &Trie{
+		mu:   rwmutex.New(safe...),
+		root: newNode(),
+	}
+}
+
+// Insert inserts a word into the trie.
+//
+//	@receiver t
+//	@param word string
+//	@player
+func (t *Trie) Insert(word string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := t.root
+	for _, r := range word {
+		child, ok := n.children[r]
+		if !ok {
+			child = newNode()
+			n.children[r] = child
+		}
+		n = child
+	}
+	if !n.end {
+		n.end = true
+		t.size++
+	}
+}
+
+// Search reports whether the word is in the trie.
+//
+//	@receiver t
+//	@param word string
+//	@return bool
+//	@player
+func (t *Trie) Search(word string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n := t.find(word)
+	return n != nil && n.end
+}
+
+// StartsWith reports whether any word in the trie starts with the prefix.
+//
+//	@receiver t
+//	@param prefix string
+//	@return bool
+//	@player
+func (t *Trie) StartsWith(prefix string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.find(prefix) != nil
+}
+
+// Delete deletes the word from the trie, it returns false if the word is not in the trie.
+//
+//	@receiver t
+//	@param word string
+//	@return bool
+//	@player
+func (t *Trie) Delete(word string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	runes := []rune(word)
+	path := make([]*node, 0, len(runes)+1)
+	n := t.root
+	path = append(path, n)
+	for _, r := range runes {
+		child, ok := n.children[r]
+		if !ok {
+			return false
+		}
+		n = child
+		path = append(path, n)
+	}
+	if !n.end {
+		return false
+	}
+	n.end = false
+	t.size--
+	// prune the nodes which are no longer used by any word
+	for i := len(runes) - 1; i >= 0; i-- {
+		child := path[i+1]
+		if child.end || len(child.children) > 0 {
+			break
+		}
+		delete(path[i].children, runes[i])
+	}
+	return true
+}
+
+// AutoComplete returns all words in the trie starting with the prefix, in lexicographic order.
+//
+//	@receiver t
+//	@param prefix string
+//	@return []string
+//	@player
+func (t *Trie) AutoComplete(prefix string) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	result := make([]string, 0)
+	n := t.find(prefix)
+	if n == nil {
+		return result
+	}
+	return n.collect([]rune(prefix), result)
+}
+
+// Size returns the number of words in the trie.
+//
+//	@receiver t
+//	@return int
+//	@player
+func (t *Trie) Size() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.size
+}
+
+func (t *Trie) find(prefix string) *node {
+	n := t.root
+	for _, r := range prefix {
+		child, ok := n.children[r]
+		if !ok {
+			return nil
+		}
+		n = child
+	}
+	return n
+}
+
+func newNode() *node {
+	return &node{children: make(map[rune]*node)}
+}
+
+func (n *node) collect(prefix []rune, result []string) []string {
+	if n.end {
+		result = append(result, string(prefix))
+	}
+	keys := make([]rune, 0, len(n.children))
+	for r := range n.children {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, r := range keys {
+		result = n.children[r].collect(append(prefix, r), result)
+	}
+	return result
+}
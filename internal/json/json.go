@@ -26,9 +26,17 @@ package json
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 )
 
+// RawMessage adapts to json/encoding RawMessage type
+type RawMessage = json.RawMessage
+
 // Marshal adapts to json/encoding Marshal API
 //
 //	@param val any
@@ -81,3 +89,268 @@ func UnmarshalUseNumber(data []byte, val any) error {
 func NewDecoder(reader io.Reader) *json.Decoder {
 	return json.NewDecoder(reader)
 }
+
+// GetField extracts the value of a top level field without unmarshaling the entire document.
+//
+//	@param data []byte
+//	@param field string
+//	@return json.RawMessage
+//	@return error
+//	@player
+func GetField(data []byte, field string) (json.RawMessage, error) {
+	decoder := NewDecoder(bytes.NewReader(data))
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if key, _ := token.(string); key == field {
+			var value json.RawMessage
+			if err = decoder.Decode(&value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}
+		if err = skipValue(decoder); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("json: field %q not found", field)
+}
+
+// ExtractPaths extracts the values of the dot separated key paths, such as "user.address.city",
+// without defining the structs of the document. It returns an error if any path does not exist.
+//
+//	@param data []byte
+//	@param paths ...string
+//	@return map[string]json.RawMessage
+//	@return error
+//	@player
+func ExtractPaths(data []byte, paths ...string) (map[string]json.RawMessage, error) {
+	result := make(map[string]json.RawMessage, len(paths))
+	// the parsed objects by the path prefix, shared by the paths with a common prefix
+	objects := make(map[string]map[string]json.RawMessage)
+	for _, path := range paths {
+		value := json.RawMessage(data)
+		prefix := ""
+		for _, key := range strings.Split(path, ".") {
+			object, ok := objects[prefix]
+			if !ok {
+				if err := json.Unmarshal(value, &object); err != nil || object == nil {
+					return nil, fmt.Errorf("json: path %q not found", path)
+				}
+				objects[prefix] = object
+			}
+			if value, ok = object[key]; !ok {
+				return nil, fmt.Errorf("json: path %q not found", path)
+			}
+			prefix += "." + key
+		}
+		result[path] = value
+	}
+	return result, nil
+}
+
+// StreamArray decodes the elements of a json array from the reader one by one and passes each to `f`,
+// so that a large array is processed without loading it into memory. It returns the first error from `f`
+// or from decoding.
+//
+//	@param r io.Reader
+//	@param f func(T) error
+//	@return error
+//	@player
+func StreamArray[T any](r io.Reader, f func(T) error) error {
+	decoder := NewDecoder(r)
+	if err := expectDelim(decoder, '['); err != nil {
+		return err
+	}
+	for decoder.More() {
+		var item T
+		if err := decoder.Decode(&item); err != nil {
+			return err
+		}
+		if err := f(item); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, ']')
+}
+
+// expectDelim reads the next token and checks it is the delimiter.
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := token.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("json: expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+// skipValue skips the next value, including all tokens of a nested object or array.
+func skipValue(decoder *json.Decoder) error {
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// Merge shallowly merges the patch object over the base object as JSON Merge Patch (RFC 7396) at the top level,
+// keys in patch overwrite keys in base, keys whose patch value is null are deleted and keys absent in patch
+// are preserved. Unlike RFC 7396, nested objects in patch replace the ones in base instead of being merged.
+//
+//	@param base []byte
+//	@param patch []byte
+//	@return []byte
+//	@return error
+//	@player
+func Merge(base, patch []byte) ([]byte, error) {
+	merged := make(map[string]json.RawMessage)
+	if err := Unmarshal(base, &merged); err != nil {
+		return nil, err
+	}
+	if merged == nil {
+		// base is null
+		merged = make(map[string]json.RawMessage)
+	}
+	patchMap := make(map[string]json.RawMessage)
+	if err := Unmarshal(patch, &patchMap); err != nil {
+		return nil, err
+	}
+	for k, v := range patchMap {
+		if bytes.Equal(bytes.TrimSpace(v), []byte("null")) {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+	return Marshal(merged)
+}
+
+// patchOperation is an operation of JSON Patch (RFC 6902).
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Diff computes a JSON Patch (RFC 6902) of add, remove and replace operations that transforms a into b.
+//
+//	@param a []byte
+//	@param b []byte
+//	@return []byte
+//	@return error
+//	@player
+func Diff(a, b []byte) ([]byte, error) {
+	var av, bv any
+	if err := UnmarshalUseNumber(a, &av); err != nil {
+		return nil, err
+	}
+	if err := UnmarshalUseNumber(b, &bv); err != nil {
+		return nil, err
+	}
+	ops := make([]patchOperation, 0)
+	ops, err := diffValue(ops, "", av, bv)
+	if err != nil {
+		return nil, err
+	}
+	return Marshal(ops)
+}
+
+func diffValue(ops []patchOperation, path string, a, b any) ([]patchOperation, error) {
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			return diffObject(ops, path, av, bv)
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			return diffArray(ops, path, av, bv)
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return ops, nil
+	}
+	return appendOperation(ops, "replace", path, b)
+}
+
+func diffObject(ops []patchOperation, path string, a, b map[string]any) ([]patchOperation, error) {
+	var err error
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	// sort keys to produce a deterministic patch
+	sort.Strings(keys)
+	for _, k := range keys {
+		p := path + "/" + escapePointer(k)
+		av, inA := a[k]
+		bv, inB := b[k]
+		switch {
+		case !inB:
+			ops = append(ops, patchOperation{Op: "remove", Path: p})
+		case !inA:
+			ops, err = appendOperation(ops, "add", p, bv)
+		default:
+			ops, err = diffValue(ops, p, av, bv)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ops, nil
+}
+
+func diffArray(ops []patchOperation, path string, a, b []any) ([]patchOperation, error) {
+	var err error
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if ops, err = diffValue(ops, path+"/"+strconv.Itoa(i), a[i], b[i]); err != nil {
+			return nil, err
+		}
+	}
+	for i := n; i < len(b); i++ {
+		if ops, err = appendOperation(ops, "add", path+"/"+strconv.Itoa(i), b[i]); err != nil {
+			return nil, err
+		}
+	}
+	// remove from the end, so that the indexes of the remaining elements are unchanged
+	for i := len(a) - 1; i >= n; i-- {
+		ops = append(ops, patchOperation{Op: "remove", Path: path + "/" + strconv.Itoa(i)})
+	}
+	return ops, nil
+}
+
+func appendOperation(ops []patchOperation, op, path string, value any) ([]patchOperation, error) {
+	raw, err := Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, patchOperation{Op: op, Path: path, Value: raw}), nil
+}
+
+// escapePointer escapes a JSON Pointer (RFC 6901) reference token.
+func escapePointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
@@ -95,3 +95,37 @@ func (r *RWMutex) RUnlock() {
 func (r *RWMutex) IsSafe() bool {
 	return r.safe
 }
+
+// WithLock calls `f` while holding the write lock of `r`.
+//
+//	@param r *RWMutex
+//	@param f func()
+//	@player
+func WithLock(r *RWMutex, f func()) {
+	r.Lock()
+	defer r.Unlock()
+	f()
+}
+
+// WithRLock calls `f` while holding the read lock of `r`.
+//
+//	@param r *RWMutex
+//	@param f func()
+//	@player
+func WithRLock(r *RWMutex, f func()) {
+	r.RLock()
+	defer r.RUnlock()
+	f()
+}
+
+// WithLockReturn calls `f` while holding the write lock of `r`, and returns the result of `f`.
+//
+//	@param r *RWMutex
+//	@param f func() T
+//	@return T
+//	@player
+func WithLockReturn[T any](r *RWMutex, f func() T) T {
+	r.Lock()
+	defer r.Unlock()
+	return f()
+}
@@ -0,0 +1,59 @@
+package sclone
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldDiff is a changed field reported by Diff.
+type FieldDiff struct {
+	// Field is the dot separated path of the field, such as "Address.City"
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// Diff compares the exported fields of two structs of the same type recursively,
+// and returns the changed fields.
+//
+//	@param a interface{} a struct or pointer to struct
+//	@param b interface{} a struct or pointer to struct
+//	@return []FieldDiff
+//	@return error
+//	@player
+func Diff(a, b interface{}) ([]FieldDiff, error) {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !av.IsValid() || !bv.IsValid() || av.Type() != bv.Type() {
+		return nil, fmt.Errorf("sclone.Diff: different types %T and %T", a, b)
+	}
+	av, bv = reflect.Indirect(av), reflect.Indirect(bv)
+	if av.Kind() != reflect.Struct || bv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("sclone.Diff: %T is not a struct or pointer to struct", a)
+	}
+	return diffStruct(make([]FieldDiff, 0), "", av, bv), nil
+}
+
+func diffStruct(diffs []FieldDiff, prefix string, a, b reflect.Value) []FieldDiff {
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		diffs = diffField(diffs, prefix+f.Name, a.Field(i), b.Field(i))
+	}
+	return diffs
+}
+
+func diffField(diffs []FieldDiff, name string, a, b reflect.Value) []FieldDiff {
+	switch {
+	case isMergeableStruct(a.Type()):
+		return diffStruct(diffs, name+".", a, b)
+	case a.Kind() == reflect.Ptr && !a.IsNil() && !b.IsNil() && isMergeableStruct(a.Type().Elem()):
+		return diffStruct(diffs, name+".", a.Elem(), b.Elem())
+	}
+	if reflect.DeepEqual(a.Interface(), b.Interface()) {
+		return diffs
+	}
+	return append(diffs, FieldDiff{Field: name, Old: a.Interface(), New: b.Interface()})
+}
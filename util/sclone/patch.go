@@ -0,0 +1,184 @@
+package sclone
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MergePatch copies only the non-zero exported fields of src into the field with the same name of dst,
+// fields that are zero in src or absent from src are left unchanged.
+// Nested structs are merged recursively, like JSON Merge Patch (RFC 7396).
+//
+//	@param dst interface{} a non-nil pointer to struct
+//	@param src interface{} a struct or pointer to struct
+//	@return error
+//	@player
+func MergePatch(dst, src interface{}) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("sclone.MergePatch: dst must be a non-nil pointer to struct, got %T", dst)
+	}
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("sclone.MergePatch: src must be a struct or pointer to struct, got %T", src)
+	}
+	if err := mergePatch(dv.Elem(), sv); err != nil {
+		return fmt.Errorf("sclone.MergePatch: %w", err)
+	}
+	return nil
+}
+
+// FieldMaskCopy copies only the fields named in `fields` from src to dst, like protobuf FieldMask.
+// Nested fields are addressed with dot separated paths, such as "Address.City".
+// An error is returned if a field does not exist in either struct, the fields before it are already copied.
+//
+//	@param dst interface{} a non-nil pointer to struct
+//	@param src interface{} a struct or pointer to struct
+//	@param fields []string go struct field names
+//	@return error
+//	@player
+func FieldMaskCopy(dst, src interface{}, fields []string) error {
+	return FieldMaskCopyByTag(dst, src, fields, "")
+}
+
+// FieldMaskCopyByTag is like FieldMaskCopy, but the fields are named by the struct tag `tag`.
+// The go struct field names are used if `tag` is empty.
+//
+//	@param dst interface{} a non-nil pointer to struct
+//	@param src interface{} a struct or pointer to struct
+//	@param fields []string
+//	@param tag string such as "json"
+//	@return error
+//	@player
+func FieldMaskCopyByTag(dst, src interface{}, fields []string, tag string) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("sclone.FieldMaskCopy: dst must be a non-nil pointer to struct, got %T", dst)
+	}
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if sv.Kind() != reflect.Struct {
+		return fmt.Errorf("sclone.FieldMaskCopy: src must be a struct or pointer to struct, got %T", src)
+	}
+	for _, field := range fields {
+		if err := copyFieldPath(dv.Elem(), sv, strings.Split(field, "."), tag); err != nil {
+			return fmt.Errorf("sclone.FieldMaskCopy: field %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+func copyFieldPath(dst, src reflect.Value, path []string, tag string) error {
+	sf, ok := fieldByMask(src, path[0], tag)
+	if !ok {
+		return fmt.Errorf("%s does not exist in %s", path[0], src.Type())
+	}
+	df, ok := fieldByMask(dst, path[0], tag)
+	if !ok {
+		return fmt.Errorf("%s does not exist in %s", path[0], dst.Type())
+	}
+	if !df.CanSet() {
+		return fmt.Errorf("%s of %s cannot be set", path[0], dst.Type())
+	}
+	if len(path) == 1 {
+		return assignValue(df, sf)
+	}
+	if sf.Kind() == reflect.Ptr {
+		if sf.IsNil() {
+			sf = reflect.Zero(sf.Type().Elem())
+		} else {
+			sf = sf.Elem()
+		}
+	}
+	if df.Kind() == reflect.Ptr {
+		if df.IsNil() {
+			df.Set(reflect.New(df.Type().Elem()))
+		}
+		df = df.Elem()
+	}
+	if sf.Kind() != reflect.Struct || df.Kind() != reflect.Struct {
+		return fmt.Errorf("%s is not a struct", path[0])
+	}
+	return copyFieldPath(df, sf, path[1:], tag)
+}
+
+func fieldByMask(v reflect.Value, name, tag string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		fieldName := f.Name
+		if tag != "" {
+			fieldName, _, _ = strings.Cut(f.Tag.Get(tag), ",")
+		}
+		if fieldName == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func mergePatch(dst, src reflect.Value) error {
+	st := src.Type()
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		sfv := src.Field(i)
+		if sfv.IsZero() {
+			continue
+		}
+		dfv := dst.FieldByName(sf.Name)
+		if !dfv.IsValid() || !dfv.CanSet() {
+			continue
+		}
+		if err := patchValue(dfv, sfv); err != nil {
+			return fmt.Errorf("field %s: %w", sf.Name, err)
+		}
+	}
+	return nil
+}
+
+func patchValue(dst, src reflect.Value) error {
+	switch {
+	case isMergeableStruct(src.Type()) && isMergeableStruct(dst.Type()):
+		return mergePatch(dst, src)
+	case src.Kind() == reflect.Ptr && dst.Kind() == reflect.Ptr &&
+		isMergeableStruct(src.Type().Elem()) && isMergeableStruct(dst.Type().Elem()):
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return mergePatch(dst.Elem(), src.Elem())
+	}
+	return assignValue(dst, src)
+}
+
+// assignValue sets src to dst if it is assignable, or convertible within the same kind.
+func assignValue(dst, src reflect.Value) error {
+	switch {
+	case src.Type().AssignableTo(dst.Type()):
+		dst.Set(src)
+	case src.Kind() == dst.Kind() && src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+	default:
+		return fmt.Errorf("cannot assign %s to %s", src.Type(), dst.Type())
+	}
+	return nil
+}
+
+// isMergeableStruct reports whether t is a struct whose fields are all exported,
+// structs with unexported fields such as time.Time are copied as a whole.
+func isMergeableStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+	return true
+}
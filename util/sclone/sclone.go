@@ -23,7 +23,11 @@
 // SOFTWARE.
 package sclone
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/go-fox/sugar/internal/json"
+)
 
 // DeepClone deep cloning
 //
@@ -96,6 +100,25 @@ func CloneMap[T any](src T) T {
 	return result.Interface().(T)
 }
 
+// CloneJSON clone by json marshaling and unmarshaling, it is slower than DeepClone but respects
+// the custom MarshalJSON and UnmarshalJSON of the type. Numbers in interface values are decoded as json.Number.
+//
+//	@param src T
+//	@return T
+//	@return error
+//	@player
+func CloneJSON[T any](src T) (T, error) {
+	var dst T
+	data, err := json.Marshal(src)
+	if err != nil {
+		return dst, err
+	}
+	if err = json.UnmarshalUseNumber(data, &dst); err != nil {
+		return dst, err
+	}
+	return dst, nil
+}
+
 type cloner struct {
 	ptrs map[reflect.Type]map[uintptr]reflect.Value
 }
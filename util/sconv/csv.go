@@ -0,0 +1,46 @@
+package sconv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseCSVRow parses a single RFC 4180 CSV line into fields, the quoted fields may contain commas,
+// escaped quotes and line breaks.
+//
+//	@param s string
+//	@return []string
+//	@return error
+//	@player
+func ParseCSVRow(s string) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(s))
+	reader.FieldsPerRecord = -1
+	fields, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err = reader.Read(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("sconv.ParseCSVRow: %q contains more than one row", s)
+	}
+	return fields, nil
+}
+
+// FormatCSVRow formats the fields to a single RFC 4180 CSV line without a trailing newline.
+//
+//	@param fields []string
+//	@return string
+//	@player
+func FormatCSVRow(fields []string) string {
+	var b strings.Builder
+	writer := csv.NewWriter(&b)
+	// writing to a strings.Builder never fails
+	_ = writer.Write(fields)
+	writer.Flush()
+	return strings.TrimSuffix(b.String(), "\n")
+}
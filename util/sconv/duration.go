@@ -0,0 +1,136 @@
+package sconv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+var humanDurationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond, "nanosecond": time.Nanosecond, "nanoseconds": time.Nanosecond,
+	"us": time.Microsecond, "µs": time.Microsecond, "μs": time.Microsecond,
+	"microsecond": time.Microsecond, "microseconds": time.Microsecond,
+	"ms": time.Millisecond, "msec": time.Millisecond, "msecs": time.Millisecond,
+	"millisecond": time.Millisecond, "milliseconds": time.Millisecond,
+	"s": time.Second, "sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+	"m": time.Minute, "min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"h": time.Hour, "hr": time.Hour, "hrs": time.Hour, "hour": time.Hour, "hours": time.Hour,
+	"d": day, "day": day, "days": day,
+	"w": week, "wk": week, "wks": week, "week": week, "weeks": week,
+}
+
+// ParseHumanDuration parses an english style duration string, such as "2 hours", "1 day",
+// "500ms" or "1 hour 30 minutes". Go style durations like "1h30m" are accepted too.
+//
+//	@param s string
+//	@return time.Duration
+//	@return error
+//	@player
+func ParseHumanDuration(s string) (time.Duration, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return 0, fmt.Errorf("sconv.ParseHumanDuration: invalid duration %q", s)
+	}
+	sign := 1.0
+	if str[0] == '-' || str[0] == '+' {
+		if str[0] == '-' {
+			sign = -1
+		}
+		str = str[1:]
+	}
+	var (
+		total float64
+		parts int
+	)
+	for {
+		str = strings.TrimLeftFunc(str, func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+		if lower := strings.ToLower(str); strings.HasPrefix(lower, "and ") {
+			str = str[len("and "):]
+			continue
+		}
+		if str == "" {
+			break
+		}
+		// number
+		i := 0
+		for i < len(str) && (str[i] >= '0' && str[i] <= '9' || str[i] == '.') {
+			i++
+		}
+		if i == 0 {
+			return 0, fmt.Errorf("sconv.ParseHumanDuration: invalid duration %q", s)
+		}
+		n, err := strconv.ParseFloat(str[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("sconv.ParseHumanDuration: invalid duration %q", s)
+		}
+		str = strings.TrimLeftFunc(str[i:], unicode.IsSpace)
+		// unit
+		j := strings.IndexFunc(str, func(r rune) bool { return !unicode.IsLetter(r) })
+		if j == -1 {
+			j = len(str)
+		}
+		unit, ok := humanDurationUnits[strings.ToLower(str[:j])]
+		if !ok {
+			return 0, fmt.Errorf("sconv.ParseHumanDuration: unknown unit %q in duration %q", str[:j], s)
+		}
+		str = str[j:]
+		total += n * float64(unit)
+		parts++
+	}
+	if parts == 0 {
+		return 0, fmt.Errorf("sconv.ParseHumanDuration: invalid duration %q", s)
+	}
+	if total > math.MaxInt64 {
+		return 0, fmt.Errorf("sconv.ParseHumanDuration: duration %q overflows", s)
+	}
+	return time.Duration(sign * total), nil
+}
+
+var formatDurationUnits = []struct {
+	unit   time.Duration
+	symbol string
+}{
+	{time.Hour, "h"}, {time.Minute, "m"}, {time.Second, "s"},
+	{time.Millisecond, "ms"}, {time.Microsecond, "µs"}, {time.Nanosecond, "ns"},
+}
+
+// FormatDuration formats the duration with at most `precision` non-zero units from the largest one,
+// the rest is truncated. Such as FormatDuration(time.Hour+2*time.Minute+3*time.Second, 2) returns "1h 2m".
+// A zero duration is formatted as "0s".
+//
+//	@param d time.Duration
+//	@param precision int
+//	@return string
+//	@player
+func FormatDuration(d time.Duration, precision int) string {
+	if d == 0 {
+		return "0s"
+	}
+	precision = max(precision, 1)
+	// use uint64 so that math.MinInt64 can be negated.
+	u := uint64(d)
+	sign := ""
+	if d < 0 {
+		u = -u
+		sign = "-"
+	}
+	parts := make([]string, 0, precision)
+	for _, unit := range formatDurationUnits {
+		if len(parts) == precision {
+			break
+		}
+		if n := u / uint64(unit.unit); n > 0 {
+			parts = append(parts, strconv.FormatUint(n, 10)+unit.symbol)
+			u %= uint64(unit.unit)
+		}
+	}
+	return sign + strings.Join(parts, " ")
+}
@@ -0,0 +1,135 @@
+package sconv
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Format formats `v` like fmt.Sprintf(format, v) with additional verbs, every verb in `format` formats `v`:
+//
+//	%r roman numerals by ToRoman
+//	%w english words by NumberToWords
+//	%d human readable duration by FormatDuration if v is a time.Duration, the precision is the number of units
+//
+// The flags and width of the additional verbs apply to the formatted string. An empty string is returned
+// and a warning is logged if a verb is unsupported for `v`.
+//
+//	@param format string
+//	@param v any
+//	@return string
+//	@player
+func Format(format string, v any) string {
+	var b strings.Builder
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+			i++
+			continue
+		}
+		j := i + 1
+		// skip the flags, width and precision
+		for j < len(format) && strings.IndexByte("+-# .0123456789", format[j]) >= 0 {
+			j++
+		}
+		if j == len(format) {
+			log.Printf("sconv.Format: missing verb at the end of %q", format)
+			return ""
+		}
+		verb, size := utf8.DecodeRuneInString(format[j:])
+		s, err := formatVerb(format[i+1:j], verb, v)
+		if err != nil {
+			log.Printf("sconv.Format: %v", err)
+			return ""
+		}
+		b.WriteString(s)
+		i = j + size
+	}
+	return b.String()
+}
+
+// formatVerb formats v with a single verb, spec is the flags, width and precision of the verb.
+func formatVerb(spec string, verb rune, v any) (string, error) {
+	switch verb {
+	case '%':
+		return "%", nil
+	case 'r':
+		n, err := ToInt(v)
+		if err != nil {
+			return "", err
+		}
+		roman, err := ToRoman(int(n))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%"+spec+"s", roman), nil
+	case 'w':
+		n, err := ToInt(v)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%"+spec+"s", NumberToWords(n)), nil
+	case 'd':
+		if d, ok := v.(time.Duration); ok {
+			spec, prec, _ := strings.Cut(spec, ".")
+			precision := len(formatDurationUnits)
+			if p, err := strconv.Atoi(prec); err == nil && p > 0 {
+				precision = p
+			}
+			return fmt.Sprintf("%"+spec+"s", FormatDuration(d, precision)), nil
+		}
+	}
+	if !supportsVerb(reflect.TypeOf(v), verb) {
+		return "", fmt.Errorf("unsupported verb %%%c for %T", verb, v)
+	}
+	return fmt.Sprintf("%"+spec+string(verb), v), nil
+}
+
+var (
+	formatterType = reflect.TypeOf((*fmt.Formatter)(nil)).Elem()
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	errorType     = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// supportsVerb reports whether fmt accepts the verb for a value of type t, %v and %T accept any value.
+func supportsVerb(t reflect.Type, verb rune) bool {
+	if verb == 'v' || verb == 'T' {
+		return true
+	}
+	if t == nil {
+		return false
+	}
+	if t.Implements(formatterType) {
+		return true
+	}
+	if (t.Implements(stringerType) || t.Implements(errorType)) && strings.ContainsRune("sqxX", verb) {
+		return true
+	}
+	var verbs string
+	switch t.Kind() {
+	case reflect.Bool:
+		verbs = "t"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		verbs = "bcdoOqxXU"
+	case reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		verbs = "beEfFgGxX"
+	case reflect.String:
+		verbs = "sqxX"
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Chan, reflect.Func:
+		verbs = "pbdoxX"
+	case reflect.Slice, reflect.Array:
+		// []byte is formatted as a string, other slices and arrays format each element with the verb
+		if t.Elem().Kind() == reflect.Uint8 && strings.ContainsRune("sqxX", verb) {
+			return true
+		}
+		return t.Kind() == reflect.Slice && verb == 'p' || supportsVerb(t.Elem(), verb)
+	case reflect.Map:
+		verbs = "p"
+	}
+	return strings.ContainsRune(verbs, verb)
+}
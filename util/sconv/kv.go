@@ -0,0 +1,132 @@
+package sconv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// ParseKV parses a string of key=value pairs separated by `sep`, such as "key1=val1,key2=val2".
+// A value can be quoted with `"` to contain separators, and a backslash escapes the next character.
+// The keys and the unquoted values are trimmed of spaces, the last value wins for duplicated keys.
+//
+//	@param s string
+//	@param sep rune
+//	@return map[string]string
+//	@return error
+//	@player
+func ParseKV(s string, sep rune) (map[string]string, error) {
+	result := make(map[string]string)
+	var (
+		buf                                 strings.Builder
+		key                                 string
+		inValue, quoted, wasQuoted, escaped bool
+	)
+	flush := func() error {
+		if !inValue {
+			if strings.TrimSpace(buf.String()) == "" {
+				return nil
+			}
+			return fmt.Errorf("sconv.ParseKV: missing = in pair %q", buf.String())
+		}
+		value := buf.String()
+		if !wasQuoted {
+			value = strings.TrimSpace(value)
+		}
+		result[key] = value
+		buf.Reset()
+		inValue, wasQuoted = false, false
+		return nil
+	}
+	for _, r := range s {
+		switch {
+		case escaped:
+			buf.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case quoted:
+			if r == '"' {
+				quoted = false
+			} else {
+				buf.WriteRune(r)
+			}
+		case r == '"' && inValue:
+			if !wasQuoted {
+				// drop the spaces before the opening quote
+				value := strings.TrimLeftFunc(buf.String(), unicode.IsSpace)
+				buf.Reset()
+				buf.WriteString(value)
+			}
+			quoted, wasQuoted = true, true
+		case r == '=' && !inValue:
+			key = strings.TrimSpace(buf.String())
+			if key == "" {
+				return nil, fmt.Errorf("sconv.ParseKV: empty key in %q", s)
+			}
+			buf.Reset()
+			inValue = true
+		case r == sep:
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		case wasQuoted && unicode.IsSpace(r):
+			// drop the spaces after the closing quote
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	if escaped || quoted {
+		return nil, fmt.Errorf("sconv.ParseKV: unterminated escape or quote in %q", s)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FormatKV formats the map to a string of key=value pairs separated by `sep` in key order,
+// it is the reverse of ParseKV.
+//
+//	@param m map[string]string
+//	@param sep rune
+//	@return string
+//	@player
+func FormatKV(m map[string]string, sep rune) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteRune(sep)
+		}
+		escapeKV(&b, k, func(r rune) bool {
+			return r == sep || r == '=' || r == '"' || r == '\\'
+		})
+		b.WriteByte('=')
+		value := m[k]
+		if strings.ContainsRune(value, sep) || strings.ContainsAny(value, `"\`) || strings.TrimSpace(value) != value {
+			b.WriteByte('"')
+			escapeKV(&b, value, func(r rune) bool {
+				return r == '"' || r == '\\'
+			})
+			b.WriteByte('"')
+			continue
+		}
+		b.WriteString(value)
+	}
+	return b.String()
+}
+
+func escapeKV(b *strings.Builder, s string, needEscape func(r rune) bool) {
+	for _, r := range s {
+		if needEscape(r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+}
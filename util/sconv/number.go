@@ -0,0 +1,129 @@
+package sconv
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	smallNumberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tensNumberWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleNumberWords = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+)
+
+// NumberToWords converts an integer to english words, such as 1234 to "one thousand two hundred thirty-four".
+//
+//	@param n int64
+//	@return string
+//	@player
+func NumberToWords(n int64) string {
+	if n == 0 {
+		return smallNumberWords[0]
+	}
+	// use uint64 so that math.MinInt64 can be negated.
+	u := uint64(n)
+	negative := n < 0
+	if negative {
+		u = -u
+	}
+	groups := make([]string, 0, len(scaleNumberWords))
+	for scale := 0; u > 0; scale++ {
+		if group := u % 1000; group > 0 {
+			words := hundredsToWords(group)
+			if scaleNumberWords[scale] != "" {
+				words += " " + scaleNumberWords[scale]
+			}
+			groups = append(groups, words)
+		}
+		u /= 1000
+	}
+	// groups are collected from the lowest scale
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	result := strings.Join(groups, " ")
+	if negative {
+		return "minus " + result
+	}
+	return result
+}
+
+func hundredsToWords(n uint64) string {
+	words := make([]string, 0, 3)
+	if n >= 100 {
+		words = append(words, smallNumberWords[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20:
+		w := tensNumberWords[n/10]
+		if n%10 > 0 {
+			w += "-" + smallNumberWords[n%10]
+		}
+		words = append(words, w)
+	case n > 0:
+		words = append(words, smallNumberWords[n])
+	}
+	return strings.Join(words, " ")
+}
+
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// ToRoman converts an integer in range 1-3999 to roman numerals, such as 1994 to "MCMXCIV".
+//
+//	@param n int
+//	@return string
+//	@return error
+//	@player
+func ToRoman(n int) (string, error) {
+	if n < 1 || n > 3999 {
+		return "", fmt.Errorf("sconv.ToRoman: %d is out of range 1-3999", n)
+	}
+	var b strings.Builder
+	for _, numeral := range romanNumerals {
+		for n >= numeral.value {
+			b.WriteString(numeral.symbol)
+			n -= numeral.value
+		}
+	}
+	return b.String(), nil
+}
+
+// FromRoman converts roman numerals to an integer, such as "MCMXCIV" to 1994.
+// Only the canonical subtractive notation is accepted.
+//
+//	@param s string
+//	@return int
+//	@return error
+//	@player
+func FromRoman(s string) (int, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+	if str == "" {
+		return 0, fmt.Errorf("sconv.FromRoman: invalid roman numeral %q", s)
+	}
+	n, rest := 0, str
+	for _, numeral := range romanNumerals {
+		for strings.HasPrefix(rest, numeral.symbol) {
+			n += numeral.value
+			rest = rest[len(numeral.symbol):]
+		}
+	}
+	if rest != "" || n > 3999 {
+		return 0, fmt.Errorf("sconv.FromRoman: invalid roman numeral %q", s)
+	}
+	// reject non canonical forms such as "IIII" or "IC" by converting it back.
+	if canonical, _ := ToRoman(n); canonical != str {
+		return 0, fmt.Errorf("sconv.FromRoman: invalid roman numeral %q", s)
+	}
+	return n, nil
+}
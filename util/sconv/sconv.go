@@ -29,8 +29,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -226,6 +228,177 @@ func ToDuration(v any) (time.Duration, error) {
 	return time.Duration(val), nil
 }
 
+// bigDecimalPrec is the precision in bits of the *big.Float returned by ToBigDecimal.
+const bigDecimalPrec = 128
+
+// ToBigDecimal convert any to *big.Float with 128 bits precision
+//
+//	@param v any
+//	@return *big.Float
+//	@return error
+//	@player
+func ToBigDecimal(v any) (*big.Float, error) {
+	f := new(big.Float).SetPrec(bigDecimalPrec)
+	switch val := v.(type) {
+	case int, int8, int16, int32, int64:
+		return f.SetInt64(reflect.ValueOf(val).Int()), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return f.SetUint64(reflect.ValueOf(val).Uint()), nil
+	case float32, float64:
+		number := reflect.ValueOf(val).Float()
+		if math.IsNaN(number) {
+			return nil, fmt.Errorf("convert NaN to big.Float failed")
+		}
+		return f.SetFloat64(number), nil
+	case *big.Int:
+		return f.SetInt(val), nil
+	case *big.Float:
+		return f.Set(val), nil
+	case json.Number:
+		return parseBigDecimal(f, val.String())
+	case string:
+		return parseBigDecimal(f, val)
+	}
+	return nil, typeAssertError(v)
+}
+
+// BigDecimalToString format *big.Float to a string with `places` decimal places
+//
+//	@param f *big.Float
+//	@param places int
+//	@return string
+//	@player
+func BigDecimalToString(f *big.Float, places int) string {
+	if f == nil {
+		return ""
+	}
+	return f.Text('f', places)
+}
+
+func parseBigDecimal(f *big.Float, s string) (*big.Float, error) {
+	if _, ok := f.SetString(s); !ok {
+		return nil, fmt.Errorf("convert %q to big.Float failed", s)
+	}
+	return f, nil
+}
+
+// TryConvert convert any to T, it dispatches to the ToXxx function of the target type,
+// such as TryConvert[int64](v) calls ToInt(v) and TryConvert[bool](v) calls ToBool(v).
+//
+//	@param v any
+//	@return T
+//	@return error
+//	@player
+func TryConvert[T any](v any) (T, error) {
+	var zero T
+	if val, ok := v.(T); ok {
+		return val, nil
+	}
+	var (
+		result any
+		err    error
+	)
+	switch any(zero).(type) {
+	case bool:
+		result, err = ToBool(v)
+	case string:
+		result, err = ToString(v)
+	case []byte:
+		result, err = ToBytes(v)
+	case time.Duration:
+		result, err = ToDuration(v)
+	case int64:
+		result, err = ToInt(v)
+	case int, int8, int16, int32, uint, uint8, uint16, uint32, uint64:
+		var n int64
+		if n, err = ToInt(v); err == nil {
+			result, err = convertInt(n, reflect.TypeOf(zero))
+		}
+	case float64:
+		result, err = ToFloat(v)
+	case float32:
+		var f float64
+		if f, err = ToFloat(v); err == nil {
+			result = float32(f)
+		}
+	case *big.Float:
+		result, err = ToBigDecimal(v)
+	default:
+		return zero, typeAssertError(v)
+	}
+	if err != nil {
+		return zero, err
+	}
+	return result.(T), nil
+}
+
+// ConvertSlice convert []any to []T by TryConvert, the elements failed to convert are left as zero values
+// and their errors are returned as a MultiError.
+//
+//	@param items []any
+//	@return []T
+//	@return error
+//	@player
+func ConvertSlice[T any](items []any) ([]T, error) {
+	result := make([]T, len(items))
+	var errs MultiError
+	for i, item := range items {
+		v, err := TryConvert[T](item)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("index %d: %w", i, err))
+			continue
+		}
+		result[i] = v
+	}
+	if len(errs) > 0 {
+		return result, errs
+	}
+	return result, nil
+}
+
+// MultiError is a list of errors, such as the errors of the elements failed in ConvertSlice.
+type MultiError []error
+
+// Error implements the interface error.
+//
+//	@receiver m
+//	@return string
+//	@player
+func (m MultiError) Error() string {
+	messages := make([]string, len(m))
+	for i, err := range m {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the errors for errors.Is and errors.As.
+//
+//	@receiver m
+//	@return []error
+//	@player
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
+// convertInt converts n to the integer type t, it returns an error if n overflows t.
+func convertInt(n int64, t reflect.Type) (any, error) {
+	rv := reflect.New(t).Elem()
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n < 0 || rv.OverflowUint(uint64(n)) {
+			return nil, fmt.Errorf("%d overflows %v", n, t)
+		}
+		rv.SetUint(uint64(n))
+	default:
+		if rv.OverflowInt(n) {
+			return nil, fmt.Errorf("%d overflows %v", n, t)
+		}
+		rv.SetInt(n)
+	}
+	return rv.Interface(), nil
+}
+
 // SliceToMap convert any to Map
 //
 //	@param array []T
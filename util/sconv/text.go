@@ -0,0 +1,176 @@
+package sconv
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Pluralize returns the count with the singular noun for count 1, or with the plural noun otherwise,
+// such as "1 item" or "3 items".
+//
+//	@param count int
+//	@param singular string
+//	@param plural string
+//	@return string
+//	@player
+func Pluralize(count int, singular, plural string) string {
+	if count == 1 {
+		return strconv.Itoa(count) + " " + singular
+	}
+	return strconv.Itoa(count) + " " + plural
+}
+
+// PluralizeN is like Pluralize, but the plural noun is the singular noun with "s" appended.
+//
+//	@param count int
+//	@param singular string
+//	@return string
+//	@player
+func PluralizeN(count int, singular string) string {
+	return Pluralize(count, singular, singular+"s")
+}
+
+// LevenshteinDistance returns the edit distance between a and b, counted in runes.
+//
+//	@param a string
+//	@param b string
+//	@return int
+//	@player
+func LevenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// keep the shorter one in rb, so that the rows use O(min(m,n)) space.
+	if len(ra) < len(rb) {
+		ra, rb = rb, ra
+	}
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// SimilarityRatio returns 1 - LevenshteinDistance(a, b) / max(len(a), len(b)), counted in runes.
+// Two empty strings are fully similar.
+//
+//	@param a string
+//	@param b string
+//	@return float64
+//	@player
+func SimilarityRatio(a, b string) float64 {
+	n := max(utf8.RuneCountInString(a), utf8.RuneCountInString(b))
+	if n == 0 {
+		return 1
+	}
+	return 1 - float64(LevenshteinDistance(a, b))/float64(n)
+}
+
+// WordCount returns the number of words in s, words are separated by unicode white space.
+//
+//	@param s string
+//	@return int
+//	@player
+func WordCount(s string) int {
+	return len(strings.Fields(s))
+}
+
+// CharCount returns the number of characters (runes) in s, not the number of bytes.
+//
+//	@param s string
+//	@return int
+//	@player
+func CharCount(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// LineCount returns the number of "\n" delimited lines in s, including the final line without "\n".
+// The empty string has 0 lines.
+//
+//	@param s string
+//	@return int
+//	@player
+func LineCount(s string) int {
+	if s == "" {
+		return 0
+	}
+	n := strings.Count(s, "\n")
+	if !strings.HasSuffix(s, "\n") {
+		n++
+	}
+	return n
+}
+
+// slugTransliterations maps common latin letters with diacritics to ascii.
+var slugTransliterations = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'æ': "ae",
+	'ç': "c", 'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ð': "d", 'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ý': "y", 'ÿ': "y", 'þ': "th", 'ß': "ss",
+}
+
+// Slugify converts s to a url safe slug, such as "Hello, World!" to "hello-world".
+// Letters are lower cased, common latin diacritics are transliterated, other non ascii characters
+// are stripped, and any run of other characters is replaced by a single hyphen.
+//
+//	@param s string
+//	@return string
+//	@player
+func Slugify(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	hyphen := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			b.WriteRune(r)
+			hyphen = false
+		case slugTransliterations[r] != "":
+			b.WriteString(slugTransliterations[r])
+			hyphen = false
+		case r > unicode.MaxASCII && unicode.IsLetter(r):
+			// stripped
+		default:
+			if !hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+				hyphen = true
+			}
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// MaskString replaces the characters of s with `mask`, except `keepLeft` characters at the start
+// and `keepRight` characters at the end. Such as MaskString("4111111111111111", 4, 4, '*') returns
+// "4111********1111". The whole string is masked if it is not longer than keepLeft + keepRight.
+//
+//	@param s string
+//	@param keepLeft int
+//	@param keepRight int
+//	@param mask rune
+//	@return string
+//	@player
+func MaskString(s string, keepLeft, keepRight int, mask rune) string {
+	runes := []rune(s)
+	keepLeft, keepRight = max(keepLeft, 0), max(keepRight, 0)
+	if keepLeft+keepRight >= len(runes) {
+		keepLeft, keepRight = 0, 0
+	}
+	for i := keepLeft; i < len(runes)-keepRight; i++ {
+		runes[i] = mask
+	}
+	return string(runes)
+}
@@ -27,6 +27,8 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // ExtractHostPort from address
@@ -69,16 +71,13 @@ func Extract(hostPort string, lis net.Listener) (string, error) {
 	if len(addr) > 0 && (addr != "0.0.0.0" && addr != "[::]" && addr != "::") {
 		return net.JoinHostPort(addr, port), nil
 	}
-	ifaces, err := net.Interfaces()
+	ifaces, err := FilterInterfaces(IsUp())
 	if err != nil {
 		return "", err
 	}
 	minIndex := int(^uint(0) >> 1)
 	ips := make([]net.IP, 0)
 	for _, iface := range ifaces {
-		if (iface.Flags & net.FlagUp) == 0 {
-			continue
-		}
 		if iface.Index >= minIndex && len(ips) != 0 {
 			continue
 		}
@@ -113,3 +112,154 @@ func Extract(hostPort string, lis net.Listener) (string, error) {
 	}
 	return "", nil
 }
+
+// MustExtract is like Extract but panics if the address cannot be extracted.
+func MustExtract(hostPort string, lis net.Listener) string {
+	addr, err := Extract(hostPort, lis)
+	if err != nil {
+		panic(fmt.Sprintf("shost: extract address from %q: %v", hostPort, err))
+	}
+	return addr
+}
+
+// GetPreferredIP returns the first local ip that falls within the first matching CIDR block of `preferences`,
+// such as []string{"10.0.0.0/8", "172.16.0.0/12", "0.0.0.0/0"}.
+func GetPreferredIP(preferences []string) (net.IP, error) {
+	blocks := make([]*net.IPNet, 0, len(preferences))
+	for _, preference := range preferences {
+		_, block, err := net.ParseCIDR(preference)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	ips, err := localIPs()
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocks {
+		for _, ip := range ips {
+			if block.Contains(ip) {
+				return ip, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no local ip matches %v", preferences)
+}
+
+// localIPs returns the valid ips of the interfaces that are up, in interface order.
+func localIPs() ([]net.IP, error) {
+	ifaces, err := FilterInterfaces(IsUp())
+	if err != nil {
+		return nil, err
+	}
+	ips := make([]net.IP, 0)
+	for _, iface := range ifaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, rawAddr := range addrs {
+			if ip := addrIP(rawAddr); ip != nil && isValidIP(ip.String()) {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, nil
+}
+
+func addrIP(addr net.Addr) net.IP {
+	switch addr := addr.(type) {
+	case *net.IPAddr:
+		return addr.IP
+	case *net.IPNet:
+		return addr.IP
+	}
+	return nil
+}
+
+// IsPortAvailable reports whether the port can be bound on all interfaces, such as network "tcp" or "udp".
+func IsPortAvailable(network string, port int) bool {
+	addr := net.JoinHostPort("0.0.0.0", strconv.Itoa(port))
+	if strings.HasPrefix(network, "udp") {
+		conn, err := net.ListenPacket(network, addr)
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}
+	lis, err := net.Listen(network, addr)
+	if err != nil {
+		return false
+	}
+	_ = lis.Close()
+	return true
+}
+
+// IsPortOpen reports whether the remote tcp port is accepting connections within timeout.
+func IsPortOpen(host string, port int, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(port)), timeout)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
+
+// InterfaceByName returns the interface specified by name.
+func InterfaceByName(name string) (*net.Interface, error) {
+	return net.InterfaceByName(name)
+}
+
+// InterfaceByIP returns the interface which owns the ip.
+func InterfaceByIP(ip net.IP) (*net.Interface, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	for i := range ifaces {
+		addrs, err := ifaces[i].Addrs()
+		if err != nil {
+			continue
+		}
+		for _, rawAddr := range addrs {
+			if addr := addrIP(rawAddr); addr != nil && addr.Equal(ip) {
+				return &ifaces[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no interface owns ip %v", ip)
+}
+
+// FilterInterfaces returns the interfaces satisfying f.
+func FilterInterfaces(f func(net.Interface) bool) ([]net.Interface, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]net.Interface, 0, len(ifaces))
+	for _, iface := range ifaces {
+		if f(iface) {
+			result = append(result, iface)
+		}
+	}
+	return result, nil
+}
+
+// IsUp returns a predicate for FilterInterfaces that selects the interfaces that are up.
+func IsUp() func(net.Interface) bool {
+	return HasFlag(net.FlagUp)
+}
+
+// IsLoopback returns a predicate for FilterInterfaces that selects the loopback interfaces.
+func IsLoopback() func(net.Interface) bool {
+	return HasFlag(net.FlagLoopback)
+}
+
+// HasFlag returns a predicate for FilterInterfaces that selects the interfaces with all the flags set.
+func HasFlag(flags net.Flags) func(net.Interface) bool {
+	return func(iface net.Interface) bool {
+		return iface.Flags&flags == flags
+	}
+}
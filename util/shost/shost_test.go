@@ -1,6 +1,10 @@
 package shost
 
-import "testing"
+import (
+	"net"
+	"testing"
+	"time"
+)
 
 func TestExtractHostPort(t *testing.T) {
 	extract, err := Extract(":90", nil)
@@ -9,3 +13,18 @@ func TestExtractHostPort(t *testing.T) {
 	}
 	println(extract)
 }
+
+func TestIsPortAvailable(t *testing.T) {
+	lis, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	port, _ := Port(lis)
+	if IsPortAvailable("tcp", port) {
+		t.Fatalf("port %d is in use, but reported available", port)
+	}
+	if !IsPortOpen("127.0.0.1", port, time.Second) {
+		t.Fatalf("port %d is listening, but reported not open", port)
+	}
+}
@@ -23,6 +23,17 @@
 // SOFTWARE.
 package sslice
 
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+const maxPowerSetLen = 20
+
 // Contain check if the target value is in the slice or not.
 //
 //	@param slice []T
@@ -130,3 +141,749 @@ func Union[T comparable, Slice ~[]T](lists ...Slice) Slice {
 
 	return result
 }
+
+// Scan returns the intermediate accumulator values of a reduction, starting with the initial value.
+// For example, running totals: Scan([]int{1, 2, 3}, 0, add) returns []int{0, 1, 3, 6}.
+//
+//	@param slice []T
+//	@param initial R
+//	@param f func(acc R, item T) R
+//	@return []R
+//	@player
+func Scan[T, R any](slice []T, initial R, f func(acc R, item T) R) []R {
+	result := make([]R, 0, len(slice)+1)
+	acc := initial
+	result = append(result, acc)
+	for _, item := range slice {
+		acc = f(acc, item)
+		result = append(result, acc)
+	}
+
+	return result
+}
+
+// CartesianProduct returns all tuples formed by picking one element from each slice.
+// A single empty tuple is returned when no slice is given.
+//
+//	@param slices ...[]T
+//	@return [][]T
+//	@player
+func CartesianProduct[T any](slices ...[]T) [][]T {
+	result := [][]T{{}}
+	for _, slice := range slices {
+		next := make([][]T, 0, len(result)*len(slice))
+		for _, tuple := range result {
+			for _, item := range slice {
+				t := make([]T, len(tuple), len(tuple)+1)
+				copy(t, tuple)
+				next = append(next, append(t, item))
+			}
+		}
+		result = next
+	}
+
+	return result
+}
+
+// Permutations returns all r-length ordered arrangements of the slice elements, in lexicographic index order.
+// It panics if r < 0 or r > len(slice).
+//
+//	@param slice []T
+//	@param r int
+//	@return [][]T
+//	@player
+func Permutations[T any](slice []T, r int) [][]T {
+	n := len(slice)
+	if r < 0 || r > n {
+		panic(fmt.Sprintf("sslice.Permutations: invalid r %d for slice of length %d", r, n))
+	}
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	cycles := make([]int, r)
+	for i := range cycles {
+		cycles[i] = n - i
+	}
+	result := [][]T{pick(slice, indices[:r])}
+	for n > 0 {
+		i := r - 1
+		for ; i >= 0; i-- {
+			cycles[i]--
+			if cycles[i] == 0 {
+				// rotate indices[i:] left by one
+				first := indices[i]
+				copy(indices[i:], indices[i+1:])
+				indices[n-1] = first
+				cycles[i] = n - i
+				continue
+			}
+			j := cycles[i]
+			indices[i], indices[n-j] = indices[n-j], indices[i]
+			result = append(result, pick(slice, indices[:r]))
+			break
+		}
+		if i < 0 {
+			break
+		}
+	}
+
+	return result
+}
+
+// Combinations returns all r-length unordered selections of the slice elements, in lexicographic index order.
+// It panics if r < 0 or r > len(slice).
+//
+//	@param slice []T
+//	@param r int
+//	@return [][]T
+//	@player
+func Combinations[T any](slice []T, r int) [][]T {
+	n := len(slice)
+	if r < 0 || r > n {
+		panic(fmt.Sprintf("sslice.Combinations: invalid r %d for slice of length %d", r, n))
+	}
+	indices := make([]int, r)
+	for i := range indices {
+		indices[i] = i
+	}
+	result := [][]T{pick(slice, indices)}
+	for {
+		i := r - 1
+		for i >= 0 && indices[i] == i+n-r {
+			i--
+		}
+		if i < 0 {
+			break
+		}
+		indices[i]++
+		for j := i + 1; j < r; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+		result = append(result, pick(slice, indices))
+	}
+
+	return result
+}
+
+func pick[T any](slice []T, indices []int) []T {
+	result := make([]T, len(indices))
+	for i, index := range indices {
+		result[i] = slice[index]
+	}
+
+	return result
+}
+
+// PowerSet returns all 2^n subsets of the slice, enumerated by bit mask.
+// It panics if len(slice) > 20 to prevent memory exhaustion.
+//
+//	@param slice []T
+//	@return [][]T
+//	@player
+func PowerSet[T any](slice []T) [][]T {
+	n := len(slice)
+	if n > maxPowerSetLen {
+		panic(fmt.Sprintf("sslice.PowerSet: slice length %d exceeds %d", n, maxPowerSetLen))
+	}
+	result := make([][]T, 0, 1<<n)
+	for mask := 0; mask < 1<<n; mask++ {
+		subset := make([]T, 0, bits.OnesCount(uint(mask)))
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				subset = append(subset, slice[i])
+			}
+		}
+		result = append(result, subset)
+	}
+
+	return result
+}
+
+// Run is a value repeated Count times in a row, see RunLengthEncode.
+type Run[T any] struct {
+	Value T
+	Count int
+}
+
+// RunLengthEncode compresses consecutive equal elements into runs.
+//
+//	@param slice []T
+//	@return []Run[T]
+//	@player
+func RunLengthEncode[T comparable](slice []T) []Run[T] {
+	result := make([]Run[T], 0)
+	for _, item := range slice {
+		if l := len(result); l > 0 && result[l-1].Value == item {
+			result[l-1].Count++
+			continue
+		}
+		result = append(result, Run[T]{Value: item, Count: 1})
+	}
+
+	return result
+}
+
+// RunLengthDecode expands runs back into a slice, runs with Count <= 0 are skipped.
+//
+//	@param runs []Run[T]
+//	@return []T
+//	@player
+func RunLengthDecode[T any](runs []Run[T]) []T {
+	size := 0
+	for _, run := range runs {
+		size += max(run.Count, 0)
+	}
+	result := make([]T, 0, size)
+	for _, run := range runs {
+		for i := 0; i < run.Count; i++ {
+			result = append(result, run.Value)
+		}
+	}
+
+	return result
+}
+
+// Transpose transposes a rectangular matrix, rows become columns.
+// It returns an error if the rows have different lengths.
+//
+//	@param matrix [][]T
+//	@return [][]T
+//	@return error
+//	@player
+func Transpose[T any](matrix [][]T) ([][]T, error) {
+	if len(matrix) == 0 {
+		return [][]T{}, nil
+	}
+	cols := len(matrix[0])
+	for i, row := range matrix {
+		if len(row) != cols {
+			return nil, fmt.Errorf("sslice.Transpose: row %d has length %d, expected %d", i, len(row), cols)
+		}
+	}
+	result := make([][]T, cols)
+	for j := range result {
+		result[j] = make([]T, len(matrix))
+		for i, row := range matrix {
+			result[j][i] = row[j]
+		}
+	}
+
+	return result, nil
+}
+
+// ZipN zips any number of slices, stopping at the shortest one.
+// The i-th element of the result holds the i-th elements of each slice.
+//
+//	@param slices ...[]T
+//	@return [][]T
+//	@player
+func ZipN[T any](slices ...[]T) [][]T {
+	if len(slices) == 0 {
+		return [][]T{}
+	}
+	size := len(slices[0])
+	for _, slice := range slices[1:] {
+		size = min(size, len(slice))
+	}
+	result := make([][]T, size)
+	for i := range result {
+		result[i] = make([]T, len(slices))
+		for j, slice := range slices {
+			result[i][j] = slice[i]
+		}
+	}
+
+	return result
+}
+
+// SortBy sorts the slice in ascending order of the key extracted by `key`.
+// The sort is stable, elements with equal keys keep their original order.
+//
+//	@param slice []T
+//	@param key func(item T) K
+//	@player
+func SortBy[T any, K cmp.Ordered](slice []T, key func(item T) K) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return cmp.Less(key(slice[i]), key(slice[j]))
+	})
+}
+
+// SortByDescending sorts the slice in descending order of the key extracted by `key`.
+// The sort is stable, elements with equal keys keep their original order.
+//
+//	@param slice []T
+//	@param key func(item T) K
+//	@player
+func SortByDescending[T any, K cmp.Ordered](slice []T, key func(item T) K) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return cmp.Less(key(slice[j]), key(slice[i]))
+	})
+}
+
+// DeduplicateBy removes duplicated elements determined by the key extracted by `key`,
+// the first occurrence is preserved. It works for elements which are not comparable.
+//
+//	@param slice []T
+//	@param key func(item T) K
+//	@return []T
+//	@player
+func DeduplicateBy[T any, K comparable](slice []T, key func(item T) K) []T {
+	result := make([]T, 0, len(slice))
+	seen := make(map[K]struct{}, len(slice))
+	for _, item := range slice {
+		k := key(item)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// FlattenDepth flattens nested slices or arrays up to `depth` levels using reflection,
+// depth=1 flattens one level and depth=-1 flattens recursively.
+// It returns an error if `slice` is not a slice or a leaf element cannot be asserted to T.
+//
+//	@param slice interface{}
+//	@param depth int
+//	@return []T
+//	@return error
+//	@player
+func FlattenDepth[T any](slice interface{}, depth int) ([]T, error) {
+	v := reflect.ValueOf(slice)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("sslice.FlattenDepth: %v is not a slice", reflect.TypeOf(slice))
+	}
+	result := make([]T, 0, v.Len())
+	return flattenDepth(v, depth, result)
+}
+
+func flattenDepth[T any](v reflect.Value, depth int, result []T) ([]T, error) {
+	var err error
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i)
+		if item.Kind() == reflect.Interface {
+			item = item.Elem()
+		}
+		if depth != 0 && (item.Kind() == reflect.Slice || item.Kind() == reflect.Array) {
+			if result, err = flattenDepth(item, depth-1, result); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !item.IsValid() {
+			var zero T
+			if reflect.TypeOf(&zero).Elem().Kind() != reflect.Interface {
+				return nil, fmt.Errorf("sslice.FlattenDepth: type assert nil to %T failed", zero)
+			}
+			result = append(result, zero)
+			continue
+		}
+		leaf, ok := item.Interface().(T)
+		if !ok {
+			return nil, fmt.Errorf("sslice.FlattenDepth: type assert %v to %v failed", item.Type(), reflect.TypeOf(&leaf).Elem())
+		}
+		result = append(result, leaf)
+	}
+
+	return result, nil
+}
+
+// Generate creates a slice of n elements, the i-th element is f(i).
+//
+//	@param n int
+//	@param f func(index int) T
+//	@return []T
+//	@player
+func Generate[T any](n int, f func(index int) T) []T {
+	result := make([]T, max(n, 0))
+	for i := range result {
+		result[i] = f(i)
+	}
+
+	return result
+}
+
+// Repeat creates a slice containing n copies of value.
+//
+//	@param value T
+//	@param n int
+//	@return []T
+//	@player
+func Repeat[T any](value T, n int) []T {
+	result := make([]T, max(n, 0))
+	for i := range result {
+		result[i] = value
+	}
+
+	return result
+}
+
+// Except returns a copy of the slice with all occurrences of the excluded values removed.
+//
+//	@param slice []T
+//	@param exclude ...T
+//	@return []T
+//	@player
+func Except[T comparable](slice []T, exclude ...T) []T {
+	excludeMap := make(map[T]struct{}, len(exclude))
+	for _, item := range exclude {
+		excludeMap[item] = struct{}{}
+	}
+	result := make([]T, 0, len(slice))
+	for _, item := range slice {
+		if _, ok := excludeMap[item]; !ok {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// Append returns a new slice with items appended, the original slice is never modified.
+// Unlike the builtin append, it always allocates a new backing array even if the capacity allows.
+//
+//	@param slice []T
+//	@param items ...T
+//	@return []T
+//	@player
+func Append[T any](slice []T, items ...T) []T {
+	result := make([]T, len(slice), len(slice)+len(items))
+	copy(result, slice)
+
+	return append(result, items...)
+}
+
+// Head returns the first element of the slice, ok is false if the slice is empty.
+//
+//	@param slice []T
+//	@return T
+//	@return bool
+//	@player
+func Head[T any](slice []T) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return slice[0], true
+}
+
+// Tail returns all but the first element of the slice, it is empty if len(slice) <= 1.
+//
+//	@param slice []T
+//	@return []T
+//	@player
+func Tail[T any](slice []T) []T {
+	if len(slice) <= 1 {
+		return []T{}
+	}
+
+	return slice[1:]
+}
+
+// Pair is a group of two values, see Pairwise.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a group of three values, see Zip3.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Quad is a group of four values, see Zip4.
+type Quad[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Zip3 zips three slices into triples, stopping at the shortest one.
+//
+//	@param as []A
+//	@param bs []B
+//	@param cs []C
+//	@return []Triple[A, B, C]
+//	@player
+func Zip3[A, B, C any](as []A, bs []B, cs []C) []Triple[A, B, C] {
+	size := min(len(as), len(bs), len(cs))
+	result := make([]Triple[A, B, C], size)
+	for i := range result {
+		result[i] = Triple[A, B, C]{First: as[i], Second: bs[i], Third: cs[i]}
+	}
+
+	return result
+}
+
+// Zip4 zips four slices into quads, stopping at the shortest one.
+//
+//	@param as []A
+//	@param bs []B
+//	@param cs []C
+//	@param ds []D
+//	@return []Quad[A, B, C, D]
+//	@player
+func Zip4[A, B, C, D any](as []A, bs []B, cs []C, ds []D) []Quad[A, B, C, D] {
+	size := min(len(as), len(bs), len(cs), len(ds))
+	result := make([]Quad[A, B, C, D], size)
+	for i := range result {
+		result[i] = Quad[A, B, C, D]{First: as[i], Second: bs[i], Third: cs[i], Fourth: ds[i]}
+	}
+
+	return result
+}
+
+// Interleave merges two slices alternately, [a[0], b[0], a[1], b[1], ...],
+// the remainder of the longer slice is appended at the end.
+//
+//	@param a []T
+//	@param b []T
+//	@return []T
+//	@player
+func Interleave[T any](a, b []T) []T {
+	result := make([]T, 0, len(a)+len(b))
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		result = append(result, a[i], b[i])
+	}
+	result = append(result, a[n:]...)
+
+	return append(result, b[n:]...)
+}
+
+// Flatten2D flattens a two-dimensional slice into one slice, the result is allocated once.
+//
+//	@param slices [][]T
+//	@return []T
+//	@player
+func Flatten2D[T any](slices [][]T) []T {
+	size := 0
+	for _, slice := range slices {
+		size += len(slice)
+	}
+	result := make([]T, 0, size)
+	for _, slice := range slices {
+		result = append(result, slice...)
+	}
+
+	return result
+}
+
+// Flatten3D flattens a three-dimensional slice into one slice, the result is allocated once.
+//
+//	@param slices [][][]T
+//	@return []T
+//	@player
+func Flatten3D[T any](slices [][][]T) []T {
+	size := 0
+	for _, matrix := range slices {
+		for _, slice := range matrix {
+			size += len(slice)
+		}
+	}
+	result := make([]T, 0, size)
+	for _, matrix := range slices {
+		for _, slice := range matrix {
+			result = append(result, slice...)
+		}
+	}
+
+	return result
+}
+
+// ForEach calls `f` for every element of the slice with its index, in order.
+//
+//	@param slice []T
+//	@param f func(index int, item T)
+//	@player
+func ForEach[T any](slice []T, f func(index int, item T)) {
+	for i, item := range slice {
+		f(i, item)
+	}
+}
+
+// Pairwise returns the adjacent pairs of the slice, [(s[0], s[1]), (s[1], s[2]), ...],
+// it is empty if len(slice) < 2.
+//
+//	@param slice []T
+//	@return []Pair[T, T]
+//	@player
+func Pairwise[T any](slice []T) []Pair[T, T] {
+	return PairwiseBy(slice, func(a, b T) Pair[T, T] {
+		return Pair[T, T]{First: a, Second: b}
+	})
+}
+
+// PairwiseBy applies `f` to each adjacent pair of the slice, such as computing the deltas of a sequence.
+//
+//	@param slice []T
+//	@param f func(a, b T) R
+//	@return []R
+//	@player
+func PairwiseBy[T, R any](slice []T, f func(a, b T) R) []R {
+	if len(slice) < 2 {
+		return []R{}
+	}
+	result := make([]R, len(slice)-1)
+	for i := range result {
+		result[i] = f(slice[i], slice[i+1])
+	}
+
+	return result
+}
+
+// Enumerate pairs each element of the slice with its index, [(0, s[0]), (1, s[1]), ...].
+//
+//	@param slice []T
+//	@return []Pair[int, T]
+//	@player
+func Enumerate[T any](slice []T) []Pair[int, T] {
+	result := make([]Pair[int, T], len(slice))
+	for i, item := range slice {
+		result[i] = Pair[int, T]{First: i, Second: item}
+	}
+
+	return result
+}
+
+// Splice removes `deleteCount` elements at `start` and inserts `items` in their place, like Array.splice in JavaScript.
+// A negative `start` counts from the end of the slice. The slice is modified in place if its capacity allows,
+// the removed elements are returned in a new slice.
+//
+//	@param slice []T
+//	@param start int
+//	@param deleteCount int
+//	@param items ...T
+//	@return newSlice []T
+//	@return removed []T
+//	@return err error
+//	@player
+func Splice[T any](slice []T, start, deleteCount int, items ...T) (newSlice []T, removed []T, err error) {
+	if start < 0 {
+		start += len(slice)
+	}
+	if start < 0 || start > len(slice) {
+		return nil, nil, fmt.Errorf("sslice.Splice: start %d out of range [0, %d]", start, len(slice))
+	}
+	if deleteCount < 0 || start+deleteCount > len(slice) {
+		return nil, nil, fmt.Errorf("sslice.Splice: delete count %d out of range [0, %d]", deleteCount, len(slice)-start)
+	}
+	removed = make([]T, deleteCount)
+	copy(removed, slice[start:start+deleteCount])
+
+	return slices.Replace(slice, start, start+deleteCount, items...), removed, nil
+}
+
+// WithIndex returns the elements satisfying `f` along with their original indices.
+//
+//	@param slice []T
+//	@param f func(item T) bool
+//	@return []Pair[int, T]
+//	@player
+func WithIndex[T any](slice []T, f func(item T) bool) []Pair[int, T] {
+	result := make([]Pair[int, T], 0)
+	for i, item := range slice {
+		if f(item) {
+			result = append(result, Pair[int, T]{First: i, Second: item})
+		}
+	}
+
+	return result
+}
+
+// Concat concatenates a slice of slices into one slice, it is the same as Flatten2D.
+//
+//	@param slices [][]T
+//	@return []T
+//	@player
+func Concat[T any](slices [][]T) []T {
+	return Flatten2D(slices)
+}
+
+// SymmetricDifference returns the elements in `a` or `b` but not both, (a - b) ∪ (b - a).
+// The elements of a come first, each element appears at most once.
+//
+//	@param a []T
+//	@param b []T
+//	@return []T
+//	@player
+func SymmetricDifference[T comparable](a, b []T) []T {
+	setA := make(map[T]struct{}, len(a))
+	for _, item := range a {
+		setA[item] = struct{}{}
+	}
+	setB := make(map[T]struct{}, len(b))
+	for _, item := range b {
+		setB[item] = struct{}{}
+	}
+	result := make([]T, 0)
+	for _, item := range a {
+		if _, ok := setB[item]; !ok {
+			result = append(result, item)
+			setB[item] = struct{}{}
+		}
+	}
+	for _, item := range b {
+		if _, ok := setA[item]; !ok {
+			result = append(result, item)
+			setA[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Partition3 splits the slice into three groups in a single pass, `a` holds the elements satisfying `first`,
+// `b` holds the elements satisfying `second` but not `first`, and `rest` holds the others.
+//
+//	@param slice []T
+//	@param first func(item T) bool
+//	@param second func(item T) bool
+//	@return a []T
+//	@return b []T
+//	@return rest []T
+//	@player
+func Partition3[T any](slice []T, first, second func(item T) bool) (a, b, rest []T) {
+	a, b, rest = make([]T, 0), make([]T, 0), make([]T, 0)
+	for _, item := range slice {
+		switch {
+		case first(item):
+			a = append(a, item)
+		case second(item):
+			b = append(b, item)
+		default:
+			rest = append(rest, item)
+		}
+	}
+
+	return a, b, rest
+}
+
+// StableSort sorts the slice by `less`, elements that are equal keep their original order.
+//
+//	@param slice []T
+//	@param less func(a, b T) bool
+//	@player
+func StableSort[T any](slice []T, less func(a, b T) bool) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		return less(slice[i], slice[j])
+	})
+}
+
+// StableSortBy sorts the slice in ascending order of the key extracted by `key`,
+// elements with equal keys keep their original order. It is the same as SortBy.
+//
+//	@param slice []T
+//	@param key func(item T) K
+//	@player
+func StableSortBy[T any, K cmp.Ordered](slice []T, key func(item T) K) {
+	SortBy(slice, key)
+}
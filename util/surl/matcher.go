@@ -0,0 +1,169 @@
+package surl
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/go-fox/sugar/internal/rwmutex"
+)
+
+// URLMatcher matches urls against patterns, such as "https://*.example.com/users/{id}" or "/static/*".
+//
+// A pattern is an optional "scheme://host" followed by a path. The host may contain `*` wildcards.
+// In the path, a `{name}` segment matches any segment and captures it as a parameter,
+// a segment containing `*` is matched as a glob within the segment, and a final `*` segment
+// matches the rest of the path.
+type URLMatcher struct {
+	mu       *rwmutex.RWMutex
+	patterns []urlPattern
+}
+
+type urlPattern struct {
+	scheme   string
+	host     string
+	segments []string
+}
+
+// NewURLMatcher new an empty URLMatcher
+//
+//	@param safe ...bool is it used during concurrency
+//	@return *URLMatcher
+//	@player
+func NewURLMatcher(safe ...bool) *URLMatcher {
+	return &URLMatcher{
+		mu: rwmutex.New(safe...),
+	}
+}
+
+// AddPattern adds a pattern, the patterns are matched in the order they are added
+//
+//	@receiver m
+//	@param pattern string
+//	@return error
+//	@player
+func (m *URLMatcher) AddPattern(pattern string) error {
+	p, err := parseURLPattern(pattern)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.patterns = append(m.patterns, p)
+	return nil
+}
+
+// Match reports whether the url matches any pattern, and returns the path parameters of the first matched pattern
+//
+//	@receiver m
+//	@param rawURL string
+//	@return bool
+//	@return map[string]string
+//	@player
+func (m *URLMatcher) Match(rawURL string) (bool, map[string]string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false, nil
+	}
+	segments := splitPath(u.Path)
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, p := range m.patterns {
+		if params, ok := p.match(u, segments); ok {
+			return true, params
+		}
+	}
+	return false, nil
+}
+
+func parseURLPattern(pattern string) (urlPattern, error) {
+	var p urlPattern
+	rest := pattern
+	if scheme, after, ok := strings.Cut(rest, "://"); ok {
+		p.scheme = strings.ToLower(scheme)
+		host, pathPart, _ := strings.Cut(after, "/")
+		if host == "" {
+			return p, fmt.Errorf("surl: invalid pattern %q: empty host", pattern)
+		}
+		p.host = strings.ToLower(host)
+		if _, err := path.Match(p.host, ""); err != nil {
+			return p, fmt.Errorf("surl: invalid pattern %q: %w", pattern, err)
+		}
+		rest = "/" + pathPart
+	}
+	if !strings.HasPrefix(rest, "/") {
+		return p, fmt.Errorf("surl: invalid pattern %q: path must start with /", pattern)
+	}
+	p.segments = splitPath(rest)
+	names := make(map[string]struct{})
+	for _, segment := range p.segments {
+		if name, ok := paramName(segment); ok {
+			if name == "" {
+				return p, fmt.Errorf("surl: invalid pattern %q: empty parameter name", pattern)
+			}
+			if _, exists := names[name]; exists {
+				return p, fmt.Errorf("surl: invalid pattern %q: duplicated parameter %s", pattern, name)
+			}
+			names[name] = struct{}{}
+			continue
+		}
+		if strings.ContainsAny(segment, "{}") {
+			return p, fmt.Errorf("surl: invalid pattern %q: invalid segment %q", pattern, segment)
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return p, fmt.Errorf("surl: invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return p, nil
+}
+
+func (p urlPattern) match(u *url.URL, segments []string) (map[string]string, bool) {
+	if p.scheme != "" && !strings.EqualFold(p.scheme, u.Scheme) {
+		return nil, false
+	}
+	if p.host != "" {
+		if ok, _ := path.Match(p.host, strings.ToLower(u.Host)); !ok {
+			return nil, false
+		}
+	}
+	params := make(map[string]string)
+	for i, segment := range p.segments {
+		// a final `*` matches the rest of the path
+		if segment == "*" && i == len(p.segments)-1 {
+			return params, true
+		}
+		if i >= len(segments) {
+			return nil, false
+		}
+		if name, ok := paramName(segment); ok {
+			if segments[i] == "" {
+				return nil, false
+			}
+			params[name] = segments[i]
+			continue
+		}
+		if ok, _ := path.Match(segment, segments[i]); !ok {
+			return nil, false
+		}
+	}
+	if len(segments) != len(p.segments) {
+		return nil, false
+	}
+	return params, true
+}
+
+func paramName(segment string) (string, bool) {
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return []string{}
+	}
+	return strings.Split(p, "/")
+}
@@ -23,7 +23,13 @@
 // SOFTWARE.
 package surl
 
-import "net/url"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const redacted = "REDACTED"
 
 // Scheme the scheme of url.URL
 //
@@ -68,3 +74,248 @@ func PickURL(urls []string, scheme string) (string, error) {
 	}
 	return "", nil
 }
+
+// ExtractQueryParams returns all query parameters of the url
+//
+//	@param rawURL string
+//	@return map[string][]string
+//	@return error
+//	@player
+func ExtractQueryParams(rawURL string) (map[string][]string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	values, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// ExtractQueryParam returns the first value of the query parameter `key`
+//
+//	@param rawURL string
+//	@param key string
+//	@return string
+//	@return bool false if the parameter is absent
+//	@return error
+//	@player
+func ExtractQueryParam(rawURL, key string) (string, bool, error) {
+	params, err := ExtractQueryParams(rawURL)
+	if err != nil {
+		return "", false, err
+	}
+	values, ok := params[key]
+	if !ok || len(values) == 0 {
+		return "", false, nil
+	}
+	return values[0], true, nil
+}
+
+// RedactPassword replaces the password in the userinfo and the value of the `password` query parameter
+// with "REDACTED", so that the url can be logged safely
+//
+//	@param rawURL string
+//	@return string
+//	@return error
+//	@player
+func RedactPassword(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	if u.RawQuery != "" {
+		// rewrite the raw query in place to keep the parameter order
+		params := strings.Split(u.RawQuery, "&")
+		for i, param := range params {
+			key, _, _ := strings.Cut(param, "=")
+			if k, err := url.QueryUnescape(key); err == nil && strings.EqualFold(k, "password") {
+				params[i] = key + "=" + redacted
+			}
+		}
+		u.RawQuery = strings.Join(params, "&")
+	}
+	return u.String(), nil
+}
+
+// WithBasicAuth sets the user and password of the url for HTTP Basic Auth
+//
+//	@param rawURL string
+//	@param user string
+//	@param password string
+//	@return string
+//	@return error
+//	@player
+func WithBasicAuth(rawURL, user, password string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	u.User = url.UserPassword(user, password)
+	return u.String(), nil
+}
+
+// StripAuth removes the user and password embedded in the url
+//
+//	@param rawURL string
+//	@return string
+//	@return error
+//	@player
+func StripAuth(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	u.User = nil
+	return u.String(), nil
+}
+
+// IsHTTPS reports whether the url is valid and its scheme is https
+//
+//	@param rawURL string
+//	@return bool
+//	@player
+func IsHTTPS(rawURL string) bool {
+	return hasScheme(rawURL, "https")
+}
+
+// IsHTTP reports whether the url is valid and its scheme is http
+//
+//	@param rawURL string
+//	@return bool
+//	@player
+func IsHTTP(rawURL string) bool {
+	return hasScheme(rawURL, "http")
+}
+
+// ToHTTPS upgrades a http url to https, the default http port 80 is removed.
+// A https url is returned as is, any other scheme is an error
+//
+//	@param rawURL string
+//	@return string
+//	@return error
+//	@player
+func ToHTTPS(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "https":
+	case "http":
+		u.Scheme = "https"
+		if u.Port() == "80" {
+			u.Host = strings.TrimSuffix(u.Host, ":80")
+		}
+	default:
+		return "", fmt.Errorf("surl.ToHTTPS: unsupported scheme %q", u.Scheme)
+	}
+	return u.String(), nil
+}
+
+// RelativeURL returns the url of `target` relative to `base`, such as "../c/d?q=1" for
+// base "https://example.com/a/b/index.html" and target "https://example.com/a/c/d?q=1".
+// Both urls must be absolute with the same scheme and host
+//
+//	@param base string
+//	@param target string
+//	@return string
+//	@return error
+//	@player
+func RelativeURL(base, target string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	t, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+	if !b.IsAbs() || !t.IsAbs() {
+		return "", fmt.Errorf("surl.RelativeURL: %q and %q must be absolute urls", base, target)
+	}
+	if b.Scheme != t.Scheme || !strings.EqualFold(b.Host, t.Host) {
+		return "", fmt.Errorf("surl.RelativeURL: %q and %q have different schemes or hosts", base, target)
+	}
+	baseDir, _ := splitDir(b.EscapedPath())
+	targetDir, targetFile := splitDir(t.EscapedPath())
+	baseSegments, targetSegments := splitPath(baseDir), splitPath(targetDir)
+	common := 0
+	for common < len(baseSegments) && common < len(targetSegments) && baseSegments[common] == targetSegments[common] {
+		common++
+	}
+	var rel strings.Builder
+	for i := common; i < len(baseSegments); i++ {
+		rel.WriteString("../")
+	}
+	for _, segment := range targetSegments[common:] {
+		rel.WriteString(segment)
+		rel.WriteByte('/')
+	}
+	rel.WriteString(targetFile)
+	result := rel.String()
+	// a first segment containing ":" would be taken as a scheme
+	if first, _, _ := strings.Cut(result, "/"); result == "" || strings.Contains(first, ":") {
+		result = "./" + result
+	}
+	if t.RawQuery != "" || t.ForceQuery {
+		result += "?" + t.RawQuery
+	}
+	if t.Fragment != "" {
+		result += "#" + t.EscapedFragment()
+	}
+	return result, nil
+}
+
+// splitDir splits the path after the last "/" into the directory and the file.
+func splitDir(p string) (dir, file string) {
+	i := strings.LastIndex(p, "/")
+	return p[:i+1], p[i+1:]
+}
+
+func hasScheme(rawURL, scheme string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && u.Scheme == scheme
+}
+
+// EncodePathSegment percent-encodes a single path segment as RFC 3986,
+// all characters except the unreserved ones (ALPHA / DIGIT / "-" / "." / "_" / "~") are encoded
+//
+//	@param segment string
+//	@return string
+//	@player
+func EncodePathSegment(segment string) string {
+	const upperhex = "0123456789ABCDEF"
+	var b strings.Builder
+	b.Grow(len(segment))
+	for i := 0; i < len(segment); i++ {
+		c := segment[i]
+		if isUnreserved(c) {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(upperhex[c>>4])
+		b.WriteByte(upperhex[c&15])
+	}
+	return b.String()
+}
+
+// DecodePathSegment decodes a percent-encoded path segment
+//
+//	@param encoded string
+//	@return string
+//	@return error
+//	@player
+func DecodePathSegment(encoded string) (string, error) {
+	return url.PathUnescape(encoded)
+}
+
+func isUnreserved(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}